@@ -11,20 +11,47 @@ import (
 	"github.com/gookit/color"
 	blk "github.com/itzmeanjan/ette/app/block"
 	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
 
 	"github.com/itzmeanjan/ette/app/rest"
+	srv "github.com/itzmeanjan/ette/app/services"
 	ss "github.com/itzmeanjan/ette/app/snapshot"
+	"github.com/itzmeanjan/ette/app/webhook"
 )
 
-// Run - Application to be invoked from main runner using this function
+// Run - Application to be invoked from main runner using this function, when
+// no command is given, it runs as per `EtteMode` set in config file
 func Run(configFile, subscriptionPlansFile string) {
 
-	ctx, cancel := context.WithCancel(context.Background())
-	_connection, _redisClient, _redisInfo, _db, _status, _queue := bootstrap(configFile, subscriptionPlansFile)
+	readConfig(configFile)
+
+	switch cfg.Get("EtteMode") {
+
+	// User has requested `ette` to take a snapshot of current database state
+	case "4":
+		TakeSnapshot(cfg.GetSnapshotFile())
+
+	// User has asked `ette` to attempt to restore from snapshotted data
+	// where data file is `snapshot.bin` in current working directory,
+	// if nothing specified for `SnapshotFile` variable in `.env`
+	case "5":
+		RestoreSnapshot(cfg.GetSnapshotFile())
+
+	default:
+		Serve(subscriptionPlansFile)
+
+	}
+
+}
+
+// handleInterrupt - Attempting to listen to Ctrl+C signal
+// and when received gracefully shutting down `ette`
+func handleInterrupt(cancel context.CancelFunc, _db *gorm.DB, _redisInfo *d.RedisInfo, _queue *q.BlockProcessorQueue) {
 
-	// Attempting to listen to Ctrl+C signal
-	// and when received gracefully shutting down `ette`
 	interruptChan := make(chan os.Signal, 1)
 	signal.Notify(interruptChan, syscall.SIGTERM, syscall.SIGINT)
 
@@ -43,6 +70,10 @@ func Run(configFile, subscriptionPlansFile string) {
 		// @note This can ( needs to ) be improved
 		cancel()
 
+		// Queue persists its final state before stopping, which needs
+		// to be done before database connection is closed
+		_queue.Wait()
+
 		sql, err := _db.DB()
 		if err != nil {
 			log.Print(color.Red.Sprintf("[!] Failed to get underlying DB connection : %s", err.Error()))
@@ -54,6 +85,11 @@ func Run(configFile, subscriptionPlansFile string) {
 			return
 		}
 
+		if err := _redisInfo.Broker.Close(); err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to close connection to broker : %s", err.Error()))
+			return
+		}
+
 		if err := _redisInfo.Client.Close(); err != nil {
 			log.Print(color.Red.Sprintf("[!] Failed to close connection to Redis : %s", err.Error()))
 			return
@@ -65,63 +101,214 @@ func Run(configFile, subscriptionPlansFile string) {
 
 	}()
 
-	// User has requested `ette` to take a snapshot of current database state
-	if cfg.Get("EtteMode") == "4" {
+}
 
-		// checking if there's anything to snapshot or not
-		if _status.BlockCountInDB() == 0 {
-			log.Printf("[*] Nothing to snapshot\n")
-			return
-		}
+// Serve - Runs `ette` in historical and/ or real-time mode, as set in config
+// file or asked for from command line, until interrupted
+//
+// Config file must be read before invoking it
+func Serve(subscriptionPlansFile string) {
 
-		// this is the file snapshot to be taken
-		_snapshotFile := cfg.GetSnapshotFile()
-		_start := time.Now().UTC()
+	ctx, cancel := context.WithCancel(context.Background())
+	_connection, _redisClient, _redisInfo, _db, _status, _queue := bootstrap(subscriptionPlansFile)
 
-		log.Printf("[*] Starting snapshotting at : %s [ Sink : %s ]\n", _start, _snapshotFile)
+	// Redis is never flushed, because streams of published data are to be
+	// kept across restarts, so that subscribers can resume, while same Redis
+	// might be shared with other applications
+	handleInterrupt(cancel, _db, _redisInfo, _queue)
 
-		// taking snapshot, this might take some time
-		_ret := ss.TakeSnapshot(_db, _snapshotFile, db.GetCurrentOldestBlockNumber(_db), db.GetCurrentBlockNumber(_db), _status.BlockCountInDB())
-		if _ret {
-			log.Print(color.Green.Sprintf("[+] Snapshotted in : %s [ Count : %d ]", time.Now().UTC().Sub(_start), _status.BlockCountInDB()))
-		} else {
-			log.Print(color.Red.Sprintf("[!] Snapshotting failed in : %s", time.Now().UTC().Sub(_start)))
-		}
+	go _queue.Start(ctx)
 
-		return
+	// Periodically checking health of all blockchain node endpoints, so that
+	// best one can be picked up, every time some job needs to be performed
+	go _connection.HealthCheck(ctx)
 
-	}
+	// Read replicas of database, if any, are checked periodically, so that
+	// only those which are not too far behind primary serve queries
+	go db.CheckReplicas(ctx, _db)
 
-	// User has asked `ette` to attempt to restore from snapshotted data
-	// where data file is `snapshot.bin` in current working directory,
-	// if nothing specified for `SnapshotFile` variable in `.env`
-	if cfg.Get("EtteMode") == "5" {
+	// Ingestion progress, queue state & endpoint health to be read
+	// every time metrics are scraped, on their own listen address
+	metrics.Register(_status, _queue, _connection)
+	go metrics.Serve(cfg.GetMetricsAddress())
 
-		_snapshotFile := cfg.GetSnapshotFile()
-		_start := time.Now().UTC()
+	// Pending tx(s) are streamed only when asked for, because not every
+	// blockchain node exposes its mempool
+	if cfg.IsPendingTxStreamingEnabled() && cfg.IsRealtime() {
+		go blk.SubscribeToPendingTransactions(_connection, _redisInfo)
+	}
 
-		log.Printf("[*] Starting snapshot restoring at : %s [ Sink : %s ]\n", _start, _snapshotFile)
+	// Persisted blocks are verified continuously only when asked for,
+	// because it keeps reading whole database, again & again
+	if cfg.IsIntegrityCheckEnabled() && cfg.IsHistorical() {
+		go blk.IntegrityVerifier(ctx, _connection, _db, _queue)
+	}
 
-		_, _count := ss.RestoreFromSnapshot(_db, _snapshotFile)
+	// Persisted blocks having event logs, which started matching ingestion
+	// filter, since it was last changed, are indexed again
+	if cfg.IsHistorical() {
+		go blk.FilterBackfill(_connection, _db, _status)
+	}
 
-		log.Print(color.Green.Sprintf("[+] Restored from snapshot in : %s [ Count : %d ]", time.Now().UTC().Sub(_start), _count))
+	// Blocks falling out of retention policies are pruned periodically, while
+	// cut off is decided before listener starts syncers, so that they don't
+	// fetch pruned blocks again
+	if cfg.IsRetentionEnabled() && cfg.IsHistorical() {
 
-		return
+		if cutOff, ok := srv.RetentionCutOff(_connection); ok {
+			_status.SetRetainedFrom(cutOff)
+		}
+
+		go srv.RetentionService(ctx, _connection, _db, _status)
 
 	}
 
-	go _queue.Start(ctx)
+	// Real-time data is delivered to webhooks only when asked for, so that
+	// when multiple `ette` instances share broker, only one of them does it
+	if cfg.IsWebhookDeliveryEnabled() && cfg.IsRealtime() {
+		go webhook.NewDispatcher(_redisInfo.Broker, _db).Start(ctx)
+	}
 
 	// Pushing block header propagation listener to another thread of execution
 	go blk.SubscribeToNewBlocks(_connection, _db, _status, _redisInfo, _queue)
 
 	// Periodic clean up job being started, to be run every 24 hours to clean up
 	// delivery history data, older than 24 hours
-	//
-	// @note Need to be diagnosed, why it doesn't work
-	// go srv.DeliveryHistoryCleanUpService(_db)
+	go srv.DeliveryHistoryCleanUpService(ctx, _db)
 
 	// Starting http server on main thread
-	rest.RunHTTPServer(_db, _status, _redisClient)
+	rest.RunHTTPServer(_connection, _db, _status, _redisClient, _redisInfo.Broker, _queue)
+
+}
+
+// TakeSnapshot - Takes snapshot of whole database, into given file
+//
+// Config file must be read before invoking it
+func TakeSnapshot(file string) bool {
+
+	_db := db.Connect()
+
+	// Only blocks from first one to be indexed, as per `StartBlock` &
+	// `HistoryWindow`, are snapshotted
+	_end := db.GetCurrentBlockNumber(_db)
+	_start := db.GetCurrentOldestBlockNumber(_db)
+	if first := cfg.GetFirstBlock(_end); _start < first {
+		_start = first
+	}
+
+	var _count uint64
+	if _start <= _end {
+		_count = db.GetBlockCountInRange(_db, _start, _end)
+	}
+
+	// checking if there's anything to snapshot or not
+	if _count == 0 {
+		log.Printf("[*] Nothing to snapshot\n")
+		return true
+	}
+
+	_startedAt := time.Now().UTC()
+
+	log.Printf("[*] Starting snapshotting at : %s [ Sink : %s ]\n", _startedAt, file)
+
+	// taking snapshot, this might take some time
+	_ret := ss.TakeSnapshot(_db, file, _start, _end, _count)
+	if _ret {
+		log.Print(color.Green.Sprintf("[+] Snapshotted in : %s [ Count : %d ]", time.Now().UTC().Sub(_startedAt), _count))
+	} else {
+		log.Print(color.Red.Sprintf("[!] Snapshotting failed in : %s", time.Now().UTC().Sub(_startedAt)))
+	}
+
+	return _ret
+
+}
+
+// RestoreSnapshot - Restores database from snapshot, read from given file
+//
+// Config file must be read before invoking it
+func RestoreSnapshot(file string) bool {
+
+	_db := db.Connect()
+	_start := time.Now().UTC()
+
+	log.Printf("[*] Starting snapshot restoring at : %s [ Sink : %s ]\n", _start, file)
+
+	_ret, _count := ss.RestoreFromSnapshot(_db, file)
+	if _ret {
+		log.Print(color.Green.Sprintf("[+] Restored from snapshot in : %s [ Count : %d ]", time.Now().UTC().Sub(_start), _count))
+	} else {
+		log.Print(color.Red.Sprintf("[!] Restoring from snapshot failed in : %s [ Count : %d ]", time.Now().UTC().Sub(_start), _count))
+	}
+
+	return _ret
+
+}
+
+// Backfill - Fetches & persists all blocks in [from, to] range, which are
+// not yet present in database, returns true if none of them are missing
+// after that
+//
+// When `to` is not provided, latest block, which has got required
+// confirmations, is used
+//
+// Config file must be read before invoking it
+func Backfill(subscriptionPlansFile string, from uint64, to uint64, toGiven bool) bool {
+
+	// Only persisting, nothing to be published
+	cfg.SetMode(true, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_connection, _, _redisInfo, _db, _status, _queue := bootstrap(subscriptionPlansFile)
+
+	handleInterrupt(cancel, _db, _redisInfo, _queue)
+
+	go _queue.Start(ctx)
+	go _connection.HealthCheck(ctx)
+
+	client := _connection.RPC.Get()
+	if client == nil {
+		log.Print(color.Red.Sprintf("[!] No healthy blockchain node found"))
+		return false
+	}
+
+	head, err := client.BlockNumber(context.Background())
+	if err != nil {
+		log.Print(color.Red.Sprintf("[!] Failed to fetch latest block number : %s", err.Error()))
+		return false
+	}
+
+	// Blocks not having required confirmations yet, are not
+	// to be backfilled, those are to be taken care of by `serve`
+	if head < cfg.GetBlockConfirmations() {
+		log.Print(color.Red.Sprintf("[!] No block has got %d confirmations yet", cfg.GetBlockConfirmations()))
+		return false
+	}
+
+	finalised := head - cfg.GetBlockConfirmations()
+	if !toGiven || to > finalised {
+		to = finalised
+	}
+
+	if from > to {
+		log.Print(color.Red.Sprintf("[!] Bad block range [ %d - %d ]", from, to))
+		return false
+	}
+
+	_queue.Latest(head)
+	_status.SetLatestBlockNumber(head)
+
+	// Failed blocks to be retried, until they're processed
+	go blk.RetryQueueManager(_connection, _db, _redisInfo, _queue, _status)
+
+	_start := time.Now().UTC()
+
+	missing := blk.Backfill(_connection, _db, _redisInfo, _queue, from, to, _status)
+	if len(missing) != 0 {
+		log.Print(color.Red.Sprintf("[!] Backfilled [ %d - %d ] in : %s, still missing %d block(s)", from, to, time.Now().UTC().Sub(_start), len(missing)))
+		return false
+	}
+
+	log.Print(color.Green.Sprintf("[+] Backfilled [ %d - %d ] in : %s [ Processed : %d ]", from, to, time.Now().UTC().Sub(_start), _status.Done()))
+	return true
 
 }
@@ -0,0 +1,78 @@
+package block
+
+import (
+	"log"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
+)
+
+// MissingBlocksInRange - Finds out which blocks in [from, to] range are not
+// present in database, while querying database in chunks
+func MissingBlocksInRange(_db *gorm.DB, from uint64, to uint64) []uint64 {
+
+	absent := make([]uint64, 0)
+
+	if !(from <= to) {
+		return absent
+	}
+
+	var step uint64 = 10000
+
+	for i := from; i <= to; i += step {
+
+		toShouldbe := i + step - 1
+		if toShouldbe > to {
+			toShouldbe = to
+		}
+
+		absent = append(absent, FindMissingBlocksInRange(db.GetAllBlockNumbersInRange(_db, i, toShouldbe), i, toShouldbe)...)
+
+	}
+
+	return absent
+
+}
+
+// Backfill - Fetches & persists all blocks in [from, to] range, which are not
+// yet present in database, while failed ones are retried by retry queue
+// manager, which must be running
+//
+// Blocks until block processor queue has nothing left to be processed,
+// returning blocks which are still missing in range
+func Backfill(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, from uint64, to uint64, status *d.StatusHolder) []uint64 {
+
+	log.Printf("✅ Starting backfill of blocks [ %d - %d ]\n", from, to)
+
+	var writer *BulkWriter
+	if cfg.IsBulkSyncEnabled() {
+		writer = NewBulkWriter(_db, status, queue)
+	}
+
+	Syncer(connection, _db, redis, queue, from, to, status, rangeSyncJob(connection, writer))
+
+	if writer != nil {
+		writer.Close()
+	}
+
+	for {
+
+		stat := queue.Stat()
+		if stat.UnconfirmedProgress == 0 && stat.UnconfirmedWaiting == 0 {
+			break
+		}
+
+		log.Printf("ℹ️ Waiting for backfill to complete [ Progress : %d, Waiting : %d ]\n", stat.UnconfirmedProgress, stat.UnconfirmedWaiting)
+		<-time.After(time.Duration(5) * time.Second)
+
+	}
+
+	log.Printf("✅ Stopping backfill of blocks [ %d - %d ]\n", from, to)
+
+	return MissingBlocksInRange(_db, from, to)
+
+}
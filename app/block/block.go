@@ -28,8 +28,8 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 		// -- 3 step pub/sub attempt
 		//
 		// Attempting to publish whole block data to redis pubsub channel
-		// when eligible `EtteMode` is set
-		if publishable && (cfg.Get("EtteMode") == "2" || cfg.Get("EtteMode") == "3") {
+		// when running in real-time mode
+		if publishable && cfg.IsRealtime() {
 
 			// 1. Asking queue whether we need to publish block or not
 			if !queue.CanPublish(block.NumberU64()) {
@@ -67,7 +67,7 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 		// pubsub channel, no need to persist data
 		//
 		// We simply publish & return from execution scope
-		if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3") {
+		if !cfg.IsHistorical() {
 
 			log.Printf("✅ Block %d with 0 tx(s) [ Took : %s ]\n", block.NumberU64(), time.Now().UTC().Sub(startingAt))
 			status.IncrementBlocksProcessed()
@@ -92,6 +92,78 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 
 	}
 
+	packedTxs, ok := FetchTransactions(client, block, _db, redis, publishable, status)
+	if !ok {
+		return false
+	}
+
+	// Internal tx(s) are obtained only when asked for, because
+	// not every blockchain node exposes `debug` namespace
+	if cfg.IsCallTracingEnabled() && !AttachInternalTransactions(client, block, packedTxs) {
+		return false
+	}
+
+	// Constructing block data to be persisted
+	//
+	// This is what we just published on pubsub channel
+	packedBlock, ok := pubsubWorker(packedTxs)
+	if !ok {
+		return false
+	}
+
+	// If `ette` being run in mode, for only publishing data to
+	// pubsub channel, no need to persist data
+	//
+	// We simply publish & return from execution scope
+	if !cfg.IsHistorical() {
+
+		log.Printf("✅ Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
+		status.IncrementBlocksProcessed()
+
+		return true
+
+	}
+
+	// If block doesn't contain any tx, we'll attempt to persist only block
+	if err := db.StoreBlock(_db, packedBlock, status, queue); err != nil {
+
+		log.Printf("❗️ Failed to process block %d : %s\n", block.NumberU64(), err.Error())
+		return false
+
+	}
+
+	// Successfully processed block
+	log.Printf("✅ Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
+
+	status.IncrementBlocksProcessed()
+	return true
+
+}
+
+// FetchTransactions - Fetches all tx(s) present in block, along with their receipts
+//
+// Attempts to fetch all receipts in as few round trips as possible, while deriving
+// senders locally, if blockchain node doesn't support it, falls back to fetching
+// each of them separately, concurrently
+//
+// Only tx(s) & event logs matching ingestion filter are returned
+func FetchTransactions(client *ethclient.Client, block *types.Block, _db *gorm.DB, redis *d.RedisInfo, publishable bool, status *d.StatusHolder) ([]*db.PackedTransaction, bool) {
+
+	receipts, err := FetchReceipts(client, block)
+	if err == nil {
+
+		packedTxs, err := BuildPackedTxs(client, block, receipts)
+		if err == nil {
+			return IngestionFilter().Apply(packedTxs), true
+		}
+
+		log.Printf("❗️ Failed to fetch tx sender(s) [ block : %d ] : %s\n", block.NumberU64(), err.Error())
+		return nil, false
+
+	}
+
+	log.Printf("ℹ️ Falling back to fetching tx(s) one by one [ block : %d ] : %s\n", block.NumberU64(), err.Error())
+
 	// Communication channel to be shared between multiple executing go routines
 	// which are trying to fetch all tx(s) present in block, concurrently
 	returnValChan := make(chan *db.PackedTransaction, runtime.NumCPU()*int(cfg.GetConcurrencyFactor()))
@@ -159,42 +231,9 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 	// -- Tx processing ending
 
 	if !(result.Failure == 0) {
-		return false
+		return nil, false
 	}
 
-	// Constructing block data to be persisted
-	//
-	// This is what we just published on pubsub channel
-	packedBlock, ok := pubsubWorker(packedTxs)
-	if !ok {
-		return false
-	}
-
-	// If `ette` being run in mode, for only publishing data to
-	// pubsub channel, no need to persist data
-	//
-	// We simply publish & return from execution scope
-	if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3") {
-
-		log.Printf("✅ Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
-		status.IncrementBlocksProcessed()
-
-		return true
-
-	}
-
-	// If block doesn't contain any tx, we'll attempt to persist only block
-	if err := db.StoreBlock(_db, packedBlock, status, queue); err != nil {
-
-		log.Printf("❗️ Failed to process block %d : %s\n", block.NumberU64(), err.Error())
-		return false
-
-	}
-
-	// Successfully processed block
-	log.Printf("✅ Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
-
-	status.IncrementBlocksProcessed()
-	return true
+	return IngestionFilter().Apply(packedTxs), true
 
 }
@@ -0,0 +1,194 @@
+package block
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gookit/color"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
+)
+
+// bulkItem - Block to be written in bulk, along with what to be invoked
+// once it's known whether it got persisted or not
+type bulkItem struct {
+	block    *db.PackedBlock
+	callback func(bool)
+}
+
+// BulkWriter - Buffers blocks far from head, fetched while syncing, & writes
+// them to database in batches, using `COPY`, instead of writing them one by one
+//
+// Blocks near head keep going through regular per block path, because
+// they may still get reorganised
+type BulkWriter struct {
+	db       *gorm.DB
+	status   *d.StatusHolder
+	queue    *q.BlockProcessorQueue
+	items    chan *bulkItem
+	done     chan struct{}
+	once     sync.Once
+	deferred bool
+	lock     sync.Mutex
+}
+
+// NewBulkWriter - Creates new bulk writer, which keeps writing buffered blocks
+// in background, until closed
+func NewBulkWriter(_db *gorm.DB, status *d.StatusHolder, queue *q.BlockProcessorQueue) *BulkWriter {
+
+	b := &BulkWriter{
+		db:     _db,
+		status: status,
+		queue:  queue,
+		items:  make(chan *bulkItem, cfg.GetBulkSyncBatchSize()),
+		done:   make(chan struct{}),
+	}
+
+	go b.run()
+	return b
+
+}
+
+// Eligible - Checks whether block is far enough from latest block
+// to be written in bulk
+func (b *BulkWriter) Eligible(number uint64) bool {
+	return number+cfg.GetBulkSyncDistance() <= b.status.GetLatestBlockNumber()
+}
+
+// Put - Buffers block to be written in bulk, callback being invoked with
+// outcome, once it's written, blocks when buffer is full
+//
+// Secondary indexes are dropped before first block is buffered, if asked for
+func (b *BulkWriter) Put(block *db.PackedBlock, callback func(bool)) {
+
+	b.once.Do(func() {
+
+		if !cfg.IsIndexDeferralEnabled() {
+			return
+		}
+
+		if err := db.DropIndexes(b.db); err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to defer indexes : %s", err.Error()))
+			return
+		}
+
+		b.lock.Lock()
+		b.deferred = true
+		b.lock.Unlock()
+
+		log.Printf("ℹ️ Deferred indexes, while writing blocks in bulk\n")
+
+	})
+
+	b.items <- &bulkItem{block: block, callback: callback}
+
+}
+
+// Close - Writes all buffered blocks & creates deferred indexes, if any,
+// returning once done, no more blocks to be put after this
+func (b *BulkWriter) Close() {
+
+	close(b.items)
+	<-b.done
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.deferred {
+		return
+	}
+
+	start := time.Now().UTC()
+
+	if err := db.CreateIndexes(b.db); err != nil {
+		log.Print(color.Red.Sprintf("[!] Failed to create deferred indexes : %s", err.Error()))
+		return
+	}
+
+	b.deferred = false
+	log.Printf("✅ Created deferred indexes [ Took : %s ]\n", time.Now().UTC().Sub(start))
+
+}
+
+// run - Keeps buffering blocks & writes them when either enough of them are
+// buffered or it's been a while since last write
+func (b *BulkWriter) run() {
+
+	size := int(cfg.GetBulkSyncBatchSize())
+	buffer := make([]*bulkItem, 0, size)
+
+	for {
+
+		select {
+
+		case item, ok := <-b.items:
+			if !ok {
+
+				b.flush(buffer)
+				close(b.done)
+				return
+
+			}
+
+			buffer = append(buffer, item)
+			if len(buffer) < size {
+				break
+			}
+
+			b.flush(buffer)
+			buffer = buffer[:0]
+
+		case <-time.After(time.Duration(1) * time.Second):
+			b.flush(buffer)
+			buffer = buffer[:0]
+
+		}
+
+	}
+
+}
+
+// flush - Writes all buffered blocks in single go, letting each of them
+// know about outcome
+func (b *BulkWriter) flush(buffer []*bulkItem) {
+
+	if len(buffer) == 0 {
+		return
+	}
+
+	start := time.Now().UTC()
+
+	blocks := make([]*db.PackedBlock, 0, len(buffer))
+	for _, v := range buffer {
+		blocks = append(blocks, v.block)
+	}
+
+	inserted, err := db.PutBlocksInBulk(b.db, blocks)
+	if err != nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to write %d block(s) in bulk : %s", len(blocks), err.Error()))
+
+		for _, v := range buffer {
+			v.callback(false)
+		}
+		return
+
+	}
+
+	for _, v := range inserted {
+		b.status.IncrementBlocksInserted()
+		b.queue.Inserted(v)
+	}
+
+	for _, v := range buffer {
+		b.status.IncrementBlocksProcessed()
+		v.callback(true)
+	}
+
+	log.Printf("✅ Wrote %d block(s) in bulk [ Inserted : %d, Took : %s ]\n", len(blocks), len(inserted), time.Now().UTC().Sub(start))
+
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
 	q "github.com/itzmeanjan/ette/app/queue"
 	"gorm.io/gorm"
 )
@@ -21,7 +23,10 @@ func FetchBlockByHash(client *ethclient.Client, hash common.Hash, number string,
 	// Starting block processing at
 	startingAt := time.Now().UTC()
 
+	start := time.Now()
+
 	block, err := client.BlockByHash(context.Background(), hash)
+	metrics.ObserveRPC("eth_getBlockByHash", start, err)
 	if err != nil {
 
 		log.Printf("❗️ Failed to fetch block %s : %s\n", number, err.Error())
@@ -39,18 +44,60 @@ func FetchBlockByNumber(client *ethclient.Client, number uint64, _db *gorm.DB, r
 	// Starting block processing at
 	startingAt := time.Now().UTC()
 
+	block, err := fetchBlockByNumber(client, number)
+	if err != nil {
+
+		log.Printf("❗️ Failed to fetch block %d : %s\n", number, err)
+		return false
+
+	}
+
+	return ProcessBlockContent(client, block, _db, redis, publishable, queue, _status, startingAt)
+
+}
+
+// fetchBlockByNumber - Fetching block, along with all tx(s) packed in it
+func fetchBlockByNumber(client *ethclient.Client, number uint64) (*types.Block, error) {
+
 	_num := big.NewInt(0)
 	_num.SetUint64(number)
 
+	start := time.Now()
+
 	block, err := client.BlockByNumber(context.Background(), _num)
+	metrics.ObserveRPC("eth_getBlockByNumber", start, err)
+
+	return block, err
+
+}
+
+// FetchPackedBlockByNumber - Fetching block content using block number, same as
+// `FetchBlockByNumber`, but neither publishing nor persisting it, rather
+// returning it, to be compared with what's already persisted
+func FetchPackedBlockByNumber(client *ethclient.Client, number uint64) (*db.PackedBlock, bool) {
+
+	block, err := fetchBlockByNumber(client, number)
 	if err != nil {
 
 		log.Printf("❗️ Failed to fetch block %d : %s\n", number, err)
-		return false
+		return nil, false
 
 	}
 
-	return ProcessBlockContent(client, block, _db, redis, publishable, queue, _status, startingAt)
+	if block.Transactions().Len() == 0 {
+		return BuildPackedBlock(block, nil), true
+	}
+
+	packedTxs, ok := FetchTransactions(client, block, nil, nil, false, nil)
+	if !ok {
+		return nil, false
+	}
+
+	if cfg.IsCallTracingEnabled() && !AttachInternalTransactions(client, block, packedTxs) {
+		return nil, false
+	}
+
+	return BuildPackedBlock(block, packedTxs), true
 
 }
 
@@ -59,7 +106,10 @@ func FetchBlockByNumber(client *ethclient.Client, number uint64, _db *gorm.DB, r
 // which will be attempted to be stored in database
 func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *types.Transaction, _db *gorm.DB, redis *d.RedisInfo, publishable bool, _status *d.StatusHolder, returnValChan chan *db.PackedTransaction) {
 
+	start := time.Now()
+
 	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+	metrics.ObserveRPC("eth_getTransactionReceipt", start, err)
 	if err != nil {
 		log.Printf("❗️ Failed to fetch tx receipt [ block : %d ] : %s\n", block.NumberU64(), err.Error())
 
@@ -69,7 +119,7 @@ func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *ty
 		return
 	}
 
-	sender, err := client.TransactionSender(context.Background(), tx, block.Hash(), receipt.TransactionIndex)
+	sender, err := GetSender(client, block, tx, receipt.TransactionIndex)
 	if err != nil {
 		log.Printf("❗️ Failed to fetch tx sender [ block : %d ] : %s\n", block.NumberU64(), err.Error())
 
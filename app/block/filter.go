@@ -0,0 +1,402 @@
+package block
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gammazero/workerpool"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	"gorm.io/gorm"
+)
+
+// FilterBackfillStep - How many blocks to be searched for newly matching
+// event logs, in one go
+const FilterBackfillStep = 1000
+
+// Filter - Decides which tx(s) & event logs are to be persisted & published,
+// while blocks themselves are always kept, for continuity
+//
+// Tx sent from/ to or deploying any of addresses is kept along with all of its
+// event logs, otherwise only event logs emitted by any of contracts & having any
+// of topics as topic0 are kept, along with tx emitting them. When only one of
+// contracts & topics is given, other one is not checked
+type Filter struct {
+	addresses map[string]bool
+	contracts map[string]bool
+	topics    map[string]bool
+}
+
+// NewFilter - Creates ingestion filter, while normalising given
+// addresses & topics, so that they can be compared with persisted ones
+func NewFilter(addresses []string, contracts []string, topics []string) *Filter {
+
+	f := &Filter{
+		addresses: make(map[string]bool, len(addresses)),
+		contracts: make(map[string]bool, len(contracts)),
+		topics:    make(map[string]bool, len(topics)),
+	}
+
+	for _, v := range addresses {
+		f.addresses[common.HexToAddress(v).Hex()] = true
+	}
+
+	for _, v := range contracts {
+		f.contracts[common.HexToAddress(v).Hex()] = true
+	}
+
+	for _, v := range topics {
+		f.topics[common.HexToHash(v).Hex()] = true
+	}
+
+	return f
+
+}
+
+var (
+	ingestionFilter     *Filter
+	ingestionFilterOnce sync.Once
+)
+
+// IngestionFilter - Ingestion filter, as set in config, which is read only
+// once, because it's consulted for every tx
+func IngestionFilter() *Filter {
+
+	ingestionFilterOnce.Do(func() {
+		ingestionFilter = NewFilter(cfg.GetFilterAddresses(), cfg.GetFilterContracts(), cfg.GetFilterTopics())
+	})
+
+	return ingestionFilter
+
+}
+
+// keys - Sorted keys of set
+func keys(set map[string]bool) []string {
+
+	_keys := make([]string, 0, len(set))
+	for k := range set {
+		_keys = append(_keys, k)
+	}
+
+	sort.Strings(_keys)
+	return _keys
+
+}
+
+// equalSets - Checks whether both sets have same keys
+func equalSets(a map[string]bool, b map[string]bool) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k := range a {
+		if !b[k] {
+			return false
+		}
+	}
+
+	return true
+
+}
+
+// Addresses - Accounts, whose tx(s) are kept
+func (f *Filter) Addresses() []string {
+	return keys(f.addresses)
+}
+
+// Contracts - Contracts, whose event logs are kept
+func (f *Filter) Contracts() []string {
+	return keys(f.contracts)
+}
+
+// Topics - Event signatures, event logs having are kept
+func (f *Filter) Topics() []string {
+	return keys(f.topics)
+}
+
+// Enabled - Whether anything is being filtered out or not
+func (f *Filter) Enabled() bool {
+	return len(f.addresses) != 0 || f.filtersEvents()
+}
+
+// filtersEvents - Whether event logs are matched on their own or not
+func (f *Filter) filtersEvents() bool {
+	return len(f.contracts) != 0 || len(f.topics) != 0
+}
+
+// Equal - Checks whether both filters keep same tx(s) & event logs
+func (f *Filter) Equal(other *Filter) bool {
+	return equalSets(f.addresses, other.addresses) && equalSets(f.contracts, other.contracts) && equalSets(f.topics, other.topics)
+}
+
+// MatchesTx - Checks whether tx is sent from/ to or deploying any of addresses
+func (f *Filter) MatchesTx(tx *db.Transactions) bool {
+	return f.addresses[tx.From] || f.addresses[tx.To] || f.addresses[tx.Contract]
+}
+
+// MatchesEvent - Checks whether event log is emitted by any of contracts &
+// has any of topics as its topic0
+func (f *Filter) MatchesEvent(event *db.Events) bool {
+
+	if !f.filtersEvents() {
+		return false
+	}
+
+	if len(f.contracts) != 0 && !f.contracts[event.Origin] {
+		return false
+	}
+
+	if len(f.topics) != 0 && !(len(event.Topics) != 0 && f.topics[event.Topics[0]]) {
+		return false
+	}
+
+	return true
+
+}
+
+// Apply - Drops tx(s) & event logs, not matching filter, along with token
+// transfers derived from dropped event logs, returns as it's, when disabled
+func (f *Filter) Apply(txs []*db.PackedTransaction) []*db.PackedTransaction {
+
+	if !f.Enabled() {
+		return txs
+	}
+
+	kept := make([]*db.PackedTransaction, 0, len(txs))
+
+	for _, v := range txs {
+
+		if f.MatchesTx(v.Tx) {
+			kept = append(kept, v)
+			continue
+		}
+
+		events := make([]*db.Events, 0, len(v.Events))
+		for _, e := range v.Events {
+
+			if f.MatchesEvent(e) {
+				events = append(events, e)
+			}
+
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+
+		v.Events = events
+		v.TokenTransfers = BuildPackedTokenTransfers(events)
+
+		kept = append(kept, v)
+
+	}
+
+	return kept
+
+}
+
+// blocksWithMatchingEvents - Finds out blocks in [from, to] range, having event
+// logs matching filter, by asking blockchain node
+func (f *Filter) blocksWithMatchingEvents(connection *d.BlockChainNodeConnection, from uint64, to uint64) ([]uint64, bool) {
+
+	query := ethereum.FilterQuery{}
+
+	for _, v := range f.Contracts() {
+		query.Addresses = append(query.Addresses, common.HexToAddress(v))
+	}
+
+	if len(f.topics) != 0 {
+
+		topics := make([]common.Hash, 0, len(f.topics))
+		for _, v := range f.Topics() {
+			topics = append(topics, common.HexToHash(v))
+		}
+
+		query.Topics = [][]common.Hash{topics}
+
+	}
+
+	numbers := make([]uint64, 0)
+
+	for i := from; i <= to; i += FilterBackfillStep {
+
+		_to := i + FilterBackfillStep - 1
+		if _to > to || _to < i {
+			_to = to
+		}
+
+		client := connection.RPC.Get()
+		if client == nil {
+			return nil, false
+		}
+
+		query.FromBlock = new(big.Int).SetUint64(i)
+		query.ToBlock = new(big.Int).SetUint64(_to)
+
+		start := time.Now()
+
+		logs, err := client.FilterLogs(context.Background(), query)
+		metrics.ObserveRPC("eth_getLogs", start, err)
+		if err != nil {
+
+			log.Printf("❗️ Failed to fetch event logs [ %d - %d ] : %s\n", i, _to, err.Error())
+			connection.RPC.Failed(client)
+			return nil, false
+
+		}
+
+		connection.RPC.Succeeded(client)
+
+		for _, v := range logs {
+
+			if len(numbers) == 0 || numbers[len(numbers)-1] != v.BlockNumber {
+				numbers = append(numbers, v.BlockNumber)
+			}
+
+		}
+
+		if _to == to {
+			break
+		}
+
+	}
+
+	return numbers, true
+
+}
+
+// FilterBackfill - Checks whether ingestion filter got changed since blocks were
+// last indexed & if event logs matching it can be found out using blockchain
+// node, re-indexes persisted blocks having them, so that newly matching tx(s) &
+// event logs get persisted
+//
+// Newly added addresses & removing filter altogether, can't be taken care
+// of this way, because those require whole range to be re-indexed
+func FilterBackfill(connection *d.BlockChainNodeConnection, _db *gorm.DB, status *d.StatusHolder) {
+
+	current := IngestionFilter()
+
+	_previous, err := db.GetIngestionFilter(_db)
+	if err != nil {
+
+		log.Printf("❗️ Failed to read last ingestion filter : %s\n", err.Error())
+		return
+
+	}
+
+	remember := func() {
+
+		if err := db.PutIngestionFilter(_db, current.Addresses(), current.Contracts(), current.Topics()); err != nil {
+			log.Printf("❗️ Failed to persist ingestion filter : %s\n", err.Error())
+		}
+
+	}
+
+	// Blocks persisted till now, if any, were indexed without
+	// any filter, so nothing is missing
+	if _previous == nil {
+		remember()
+		return
+	}
+
+	previous := NewFilter(_previous.Addresses, _previous.Contracts, _previous.Topics)
+	if previous.Equal(current) {
+		return
+	}
+
+	// Everything was being indexed, so filter can only drop
+	// what's to be indexed from now on
+	if !previous.Enabled() {
+		remember()
+		return
+	}
+
+	log.Printf("ℹ️ Ingestion filter changed\n")
+
+	if previous.Enabled() && !current.Enabled() {
+		log.Printf("❗️ Ingestion filter removed, run `ette reindex` for persisting whole range\n")
+	}
+
+	for k := range current.addresses {
+
+		if !previous.addresses[k] {
+			log.Printf("❗️ Ingestion filter has new addresses, run `ette reindex` for persisting their tx(s)\n")
+			break
+		}
+
+	}
+
+	latest := db.GetCurrentBlockNumber(_db)
+	from := db.GetCurrentOldestBlockNumber(_db)
+	if first := cfg.GetFirstBlock(latest); from < first {
+		from = first
+	}
+
+	if !current.filtersEvents() || (equalSets(previous.contracts, current.contracts) && equalSets(previous.topics, current.topics)) || from > latest {
+		remember()
+		return
+	}
+
+	log.Printf("✅ Starting ingestion filter backfill [ %d - %d ]\n", from, latest)
+
+	numbers, ok := current.blocksWithMatchingEvents(connection, from, latest)
+	if !ok {
+
+		log.Printf("❗️ Failed to find blocks having matching event logs, to be retried on next start up\n")
+		return
+
+	}
+
+	summary := &d.ReindexSummary{From: from, To: latest, Blocks: make([]*d.ReindexReport, 0)}
+
+	var lock sync.Mutex
+
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+
+	for _, v := range numbers {
+
+		// Only persisted blocks are re-indexed, missing ones
+		// will be processed with new filter anyway
+		if db.GetBlock(_db, v) == nil {
+			continue
+		}
+
+		func(num uint64) {
+			wp.Submit(func() {
+
+				report := ReindexBlock(connection, _db, num, status)
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				summary.Add(report)
+
+			})
+		}(v)
+
+	}
+
+	wp.StopWait()
+
+	if summary.Failed != 0 {
+
+		log.Printf("❗️ Failed to re-index %d block(s), while backfilling for ingestion filter, to be retried on next start up\n", summary.Failed)
+		return
+
+	}
+
+	remember()
+
+	log.Printf("✅ Stopping ingestion filter backfill [ %d - %d ] [ Inserted : %d, Updated : %d, Replaced : %d, Unchanged : %d ]\n", from, latest, summary.Inserted, summary.Updated, summary.Replaced, summary.Unchanged)
+
+}
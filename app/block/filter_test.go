@@ -0,0 +1,140 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+func TestFilterApply(t *testing.T) {
+
+	var (
+		alice    = common.HexToAddress("0x1").Hex()
+		bob      = common.HexToAddress("0x2").Hex()
+		token    = common.HexToAddress("0x3").Hex()
+		other    = common.HexToAddress("0x4").Hex()
+		approval = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925").Hex()
+	)
+
+	transfer := &db.Events{
+		Origin: token,
+		Index:  0,
+		Topics: []string{TransferTopic, common.HexToHash(alice).Hex(), common.HexToHash(bob).Hex()},
+		Data:   common.LeftPadBytes([]byte{1}, 32),
+	}
+
+	approve := &db.Events{
+		Origin: token,
+		Index:  1,
+		Topics: []string{approval, common.HexToHash(alice).Hex(), common.HexToHash(bob).Hex()},
+		Data:   common.LeftPadBytes([]byte{1}, 32),
+	}
+
+	emitted := &db.Events{
+		Origin: other,
+		Index:  2,
+		Topics: []string{TransferTopic},
+	}
+
+	// Fresh tx(s) are built for each case, because filter modifies them
+	txs := func() []*db.PackedTransaction {
+		return []*db.PackedTransaction{
+			{
+				Tx:     &db.Transactions{Hash: "0xa", From: alice, To: token},
+				Events: []*db.Events{transfer, approve},
+			},
+			{
+				Tx:     &db.Transactions{Hash: "0xb", From: bob, To: other},
+				Events: []*db.Events{emitted},
+			},
+			{
+				Tx: &db.Transactions{Hash: "0xc", From: bob, Contract: other},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		filter    *Filter
+		hashes    []string
+		events    map[string]int
+		transfers map[string]int
+	}{
+		{
+			name:   "disabled",
+			filter: NewFilter(nil, nil, nil),
+			hashes: []string{"0xa", "0xb", "0xc"},
+		},
+		{
+			name:      "sender",
+			filter:    NewFilter([]string{alice}, nil, nil),
+			hashes:    []string{"0xa"},
+			events:    map[string]int{"0xa": 2},
+			transfers: map[string]int{"0xa": 0},
+		},
+		{
+			name:   "deployed contract",
+			filter: NewFilter([]string{other}, nil, nil),
+			hashes: []string{"0xb", "0xc"},
+			events: map[string]int{"0xb": 1, "0xc": 0},
+		},
+		{
+			name:      "emitter contract",
+			filter:    NewFilter(nil, []string{token}, nil),
+			hashes:    []string{"0xa"},
+			events:    map[string]int{"0xa": 2},
+			transfers: map[string]int{"0xa": 1},
+		},
+		{
+			name:      "topic",
+			filter:    NewFilter(nil, nil, []string{approval}),
+			hashes:    []string{"0xa"},
+			events:    map[string]int{"0xa": 1},
+			transfers: map[string]int{"0xa": 0},
+		},
+		{
+			name:      "emitter contract & topic",
+			filter:    NewFilter(nil, []string{token, other}, []string{TransferTopic}),
+			hashes:    []string{"0xa", "0xb"},
+			events:    map[string]int{"0xa": 1, "0xb": 1},
+			transfers: map[string]int{"0xa": 1, "0xb": 0},
+		},
+		{
+			name:   "nothing matching",
+			filter: NewFilter([]string{common.HexToAddress("0x5").Hex()}, nil, nil),
+			hashes: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			kept := tt.filter.Apply(txs())
+
+			if len(kept) != len(tt.hashes) {
+				t.Fatalf("expected %d tx(s), kept %d", len(tt.hashes), len(kept))
+			}
+
+			for k, v := range kept {
+
+				if v.Tx.Hash != tt.hashes[k] {
+					t.Errorf("expected tx %s at %d, kept %s", tt.hashes[k], k, v.Tx.Hash)
+				}
+
+				if count, ok := tt.events[v.Tx.Hash]; ok && len(v.Events) != count {
+					t.Errorf("expected %d event log(s) of tx %s, kept %d", count, v.Tx.Hash, len(v.Events))
+				}
+
+				if count, ok := tt.transfers[v.Tx.Hash]; ok && len(v.TokenTransfers) != count {
+					t.Errorf("expected %d token transfer(s) of tx %s, kept %d", count, v.Tx.Hash, len(v.TokenTransfers))
+				}
+
+			}
+
+		})
+
+	}
+
+}
@@ -0,0 +1,439 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/trie"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
+)
+
+// IntegrityChunkSize - How many persisted blocks to be verified in one go
+const IntegrityChunkSize = 1000
+
+// verifyBlockRows - Checks whether tx(s) & event logs persisted for block
+// agree with what block header says about them
+//
+// Tx & receipt roots are never recomputed from persisted rows, because tx
+// signatures, positions in block & receipt fields needed for it are not kept,
+// so this doesn't prove persisted rows are consistent with block. Only cheap
+// heuristics are checked i.e. whether having no rows agrees with empty roots,
+// gas used by tx(s) sums up to gas used by block & log indices are contiguous,
+// which catches partially persisted blocks, but blocks are never processed
+// again, only because of them. Roots are recomputed only for sampled blocks,
+// from what blockchain node has, see `verifyAgainstNode`
+//
+// When ingestion filter is enabled, only having rows for empty roots is checked,
+// because rest of them are expected to be dropped, while log indices are not
+// checked either, when event logs are being pruned by retention service
+func verifyBlockRows(block *db.Blocks, txs *db.BlockRowStats, events *db.BlockRowStats, filtered bool, pruned bool) []*db.IntegrityFindings {
+
+	findings := make([]*db.IntegrityFindings, 0)
+
+	finding := func(kind string, detail string, args ...interface{}) {
+		findings = append(findings, &db.IntegrityFindings{
+			Number: block.Number,
+			Hash:   block.Hash,
+			Kind:   kind,
+			Detail: fmt.Sprintf(detail, args...),
+		})
+	}
+
+	emptyTxRoot := block.TransactionRootHash == types.EmptyTxsHash.Hex()
+	emptyReceiptRoot := block.ReceiptRootHash == types.EmptyReceiptsHash.Hex()
+
+	if txs == nil {
+
+		if filtered {
+			return findings
+		}
+
+		if !emptyTxRoot {
+			finding(db.TxRootFinding, "tx root %s is not empty, but no tx persisted", block.TransactionRootHash)
+		}
+
+		if !emptyReceiptRoot {
+			finding(db.ReceiptRootFinding, "receipt root %s is not empty, but no tx persisted", block.ReceiptRootHash)
+		}
+
+	} else {
+
+		if emptyTxRoot {
+			finding(db.TxRootFinding, "tx root is empty, but %d tx(s) persisted", txs.Count)
+		}
+
+		if emptyReceiptRoot {
+			finding(db.ReceiptRootFinding, "receipt root is empty, but %d tx(s) persisted", txs.Count)
+		}
+
+		// Tx(s) persisted before gas used was being kept, have it 0, which
+		// can never be true for any tx, so they're not compared
+		if !filtered && txs.GasUsed != 0 && txs.GasUsed != block.GasUsed {
+			finding(db.GasUsedFinding, "block used %d gas, but persisted tx(s) used %d", block.GasUsed, txs.GasUsed)
+		}
+
+	}
+
+	if !filtered && !pruned && events != nil && !(events.MinIndex == 0 && events.MaxIndex+1 == events.Count) {
+		finding(db.LogIndexFinding, "%d event log(s) persisted, with log index in [ %d - %d ]", events.Count, events.MinIndex, events.MaxIndex)
+	}
+
+	return findings
+
+}
+
+// eventsPruned - Whether event logs of persisted blocks are being pruned by
+// retention service, leaving gaps in their log indices
+//
+// Retention service prunes event logs of all blocks, starting from retention
+// cut off, which are the only ones being kept, so all persisted blocks are
+// considered to be in pruned range
+func eventsPruned() bool {
+	return cfg.IsRetentionEnabled() && len(cfg.GetRetentionContracts()) != 0
+}
+
+// nodeReceipts - Fetches receipts of all tx(s) packed in block, from blockchain
+// node, one by one, if they can't be fetched in fewer round trips
+func nodeReceipts(client *ethclient.Client, block *types.Block) (types.Receipts, error) {
+
+	receipts, err := FetchReceipts(client, block)
+	if err == nil {
+		return receipts, nil
+	}
+
+	receipts = make([]*types.Receipt, 0, block.Transactions().Len())
+
+	for _, v := range block.Transactions() {
+
+		start := time.Now()
+
+		receipt, err := client.TransactionReceipt(context.Background(), v.Hash())
+		metrics.ObserveRPC("eth_getTransactionReceipt", start, err)
+		if err != nil {
+			return nil, err
+		}
+
+		receipts = append(receipts, receipt)
+
+	}
+
+	if err := validateReceipts(block, receipts); err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+
+}
+
+// verifyAgainstNode - Compares persisted block with block at same height, as
+// seen by blockchain node, i.e. their hashes, tx & receipt roots, recomputed
+// from tx(s) & receipts fetched from node & tx(s) packed in them
+//
+// When ingestion filter is enabled, it's only checked whether all persisted
+// tx(s) are present in block
+func verifyAgainstNode(connection *d.BlockChainNodeConnection, _db *gorm.DB, block *db.Blocks, filtered bool) ([]*db.IntegrityFindings, bool) {
+
+	client := connection.RPC.Get()
+	if client == nil {
+		return nil, false
+	}
+
+	_block, err := fetchBlockByNumber(client, block.Number)
+	if err != nil {
+
+		log.Printf("❗️ Failed to fetch block %d : %s\n", block.Number, err.Error())
+		return nil, false
+
+	}
+
+	findings := make([]*db.IntegrityFindings, 0)
+
+	finding := func(kind string, detail string, args ...interface{}) {
+		findings = append(findings, &db.IntegrityFindings{
+			Number: block.Number,
+			Hash:   block.Hash,
+			Kind:   kind,
+			Detail: fmt.Sprintf(detail, args...),
+		})
+	}
+
+	if _block.Hash().Hex() != block.Hash {
+
+		finding(db.BlockHashFinding, "blockchain node has block %s at same height", _block.Hash().Hex())
+		return findings, true
+
+	}
+
+	receipts, err := nodeReceipts(client, _block)
+	if err != nil {
+
+		log.Printf("❗️ Failed to fetch receipts of block %d : %s\n", block.Number, err.Error())
+		return nil, false
+
+	}
+
+	if root := types.DeriveSha(_block.Transactions(), trie.NewStackTrie(nil)).Hex(); root != block.TransactionRootHash {
+		finding(db.NodeTxRootFinding, "tx root %s recomputed from tx(s) fetched from blockchain node, doesn't match with %s", root, block.TransactionRootHash)
+	}
+
+	if root := types.DeriveSha(receipts, trie.NewStackTrie(nil)).Hex(); root != block.ReceiptRootHash {
+		finding(db.NodeReceiptRootFinding, "receipt root %s recomputed from receipts fetched from blockchain node, doesn't match with %s", root, block.ReceiptRootHash)
+	}
+
+	hashes := db.GetTransactionHashesByBlockHash(_db, block.Hash)
+	if hashes == nil {
+		return nil, false
+	}
+
+	persisted := make(map[string]bool, len(hashes))
+	for _, v := range hashes {
+		persisted[v] = true
+	}
+
+	var missing, found int
+	for _, v := range _block.Transactions() {
+
+		if !persisted[v.Hash().Hex()] {
+			missing++
+			continue
+		}
+
+		found++
+
+	}
+
+	if filtered && found != len(hashes) {
+		finding(db.TxSetFinding, "%d tx(s) persisted, %d of them not present in block, as per blockchain node", len(hashes), len(hashes)-found)
+	}
+
+	if !filtered && (missing != 0 || len(hashes) != _block.Transactions().Len()) {
+		finding(db.TxSetFinding, "blockchain node has %d tx(s) in block, %d persisted, %d of them missing", _block.Transactions().Len(), len(hashes), missing)
+	}
+
+	return findings, true
+
+}
+
+// VerifyIntegrity - Verifies all persisted blocks in [from, to] range, checking
+// whether each of them builds on top of previous one & its persisted rows agree
+// with its header, while comparing `samples` randomly picked ones against
+// blockchain node, returning findings & how many blocks were verified
+func VerifyIntegrity(connection *d.BlockChainNodeConnection, _db *gorm.DB, from uint64, to uint64, samples uint64) ([]*db.IntegrityFindings, int, bool) {
+
+	// Previous block is required for checking parent hash continuity
+	// of first one in range
+	_from := from
+	if _from > 0 {
+		_from--
+	}
+
+	blocks := db.GetBlocksInRange(_db, _from, to)
+	if blocks == nil {
+		return nil, 0, false
+	}
+
+	if len(blocks) == 0 {
+		return nil, 0, true
+	}
+
+	hashes := make([]string, 0, len(blocks))
+	for _, v := range blocks {
+		hashes = append(hashes, v.Hash)
+	}
+
+	txs := db.GetTransactionStatsByBlockHashes(_db, hashes)
+	if txs == nil {
+		return nil, 0, false
+	}
+
+	events := db.GetEventStatsByBlockHashes(_db, hashes)
+	if events == nil {
+		return nil, 0, false
+	}
+
+	findings := make([]*db.IntegrityFindings, 0)
+	verified := make([]*db.Blocks, 0, len(blocks))
+	filtered := IngestionFilter().Enabled()
+	pruned := eventsPruned()
+
+	var previous *db.Blocks
+
+	for _, v := range blocks {
+
+		// Only checking parent hash continuity, when previous block
+		// is also persisted
+		if previous != nil && previous.Number+1 == v.Number && previous.Hash != v.ParentHash {
+
+			findings = append(findings, &db.IntegrityFindings{
+				Number: v.Number,
+				Hash:   v.Hash,
+				Kind:   db.ParentHashFinding,
+				Detail: fmt.Sprintf("parent hash %s doesn't match with hash %s of block %d", v.ParentHash, previous.Hash, previous.Number),
+			})
+
+		}
+
+		previous = v
+
+		if v.Number < from {
+			continue
+		}
+
+		findings = append(findings, verifyBlockRows(v, txs[v.Hash], events[v.Hash], filtered, pruned)...)
+		verified = append(verified, v)
+
+	}
+
+	rand.Shuffle(len(verified), func(i, j int) {
+		verified[i], verified[j] = verified[j], verified[i]
+	})
+
+	for i := 0; i < len(verified) && uint64(i) < samples; i++ {
+
+		_findings, ok := verifyAgainstNode(connection, _db, verified[i], filtered)
+		if !ok {
+			return nil, 0, false
+		}
+
+		findings = append(findings, _findings...)
+
+	}
+
+	return findings, len(verified), true
+
+}
+
+// isConfirmed - Whether finding is confirmed by comparing against blockchain
+// node or previous block, rather than being derived from persisted rows, using
+// heuristics, which can only be recorded & reported
+func isConfirmed(kind string) bool {
+
+	switch kind {
+
+	case db.ParentHashFinding, db.BlockHashFinding, db.TxSetFinding, db.NodeTxRootFinding, db.NodeReceiptRootFinding:
+		return true
+	default:
+		return false
+
+	}
+
+}
+
+// requeueBadBlocks - Puts blocks having confirmed inconsistencies back into
+// processing queue, to be processed from scratch, without being published,
+// because they're old blocks being repaired
+//
+// Blocks having bad rows are deleted first, otherwise they'll be considered
+// already persisted, because their header is fine, while for broken parent
+// hash continuity, both blocks are requeued, because any of them can be
+// the one from orphaned branch
+func requeueBadBlocks(_db *gorm.DB, queue *q.BlockProcessorQueue, findings []*db.IntegrityFindings) {
+
+	requeued := make(map[uint64]bool)
+
+	for _, v := range findings {
+
+		if requeued[v.Number] || !isConfirmed(v.Kind) {
+			continue
+		}
+
+		if v.Kind == db.ParentHashFinding {
+
+			if v.Number > 0 && !requeued[v.Number-1] {
+				queue.RequeueSilently(v.Number - 1)
+				requeued[v.Number-1] = true
+			}
+
+		} else if err := db.DeleteBlock(_db, v.Number); err != nil {
+
+			log.Printf("❗️ Failed to delete bad block %d : %s\n", v.Number, err.Error())
+			continue
+
+		}
+
+		queue.RequeueSilently(v.Number)
+		requeued[v.Number] = true
+
+		log.Printf("🔁 Requeued bad block %d [ Finding : %s ]\n", v.Number, v.Kind)
+
+	}
+
+}
+
+// IntegrityVerifier - Keeps walking through persisted blocks, chunk by chunk,
+// from oldest to latest & starting over once done, while recording findings
+// in database & metrics, along with queueing bad blocks for processing again
+//
+// To be run as an independent go routine
+func IntegrityVerifier(ctx context.Context, connection *d.BlockChainNodeConnection, _db *gorm.DB, queue *q.BlockProcessorQueue) {
+
+	next := db.GetCurrentOldestBlockNumber(_db)
+	metrics.SetIntegrityFindings(db.GetIntegrityFindingCounts(_db))
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-time.After(time.Duration(cfg.GetIntegrityCheckInterval()) * time.Second):
+
+			latest := db.GetCurrentBlockNumber(_db)
+
+			// Done with walking through all persisted blocks, starting over
+			if next > latest {
+				next = db.GetCurrentOldestBlockNumber(_db)
+			}
+
+			if next > latest {
+				break
+			}
+
+			to := next + IntegrityChunkSize - 1
+			if to > latest {
+				to = latest
+			}
+
+			findings, verified, ok := VerifyIntegrity(connection, _db, next, to, cfg.GetIntegrityCheckSamples())
+			if !ok {
+
+				log.Printf("❗️ Failed to verify integrity of blocks [ %d - %d ]\n", next, to)
+				break
+
+			}
+
+			if err := db.PutIntegrityFindings(_db, next, to, findings); err != nil {
+
+				log.Printf("❗️ Failed to persist integrity findings of blocks [ %d - %d ] : %s\n", next, to, err.Error())
+				break
+
+			}
+
+			metrics.IntegrityBlocksVerified(verified)
+			metrics.SetIntegrityFindings(db.GetIntegrityFindingCounts(_db))
+
+			if len(findings) != 0 {
+
+				log.Printf("❗️ Found %d inconsistencies in blocks [ %d - %d ]\n", len(findings), next, to)
+				requeueBadBlocks(_db, queue, findings)
+
+			}
+
+			next = to + 1
+
+		}
+
+	}
+
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"log"
 	"runtime"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
@@ -21,12 +24,41 @@ import (
 func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB, status *d.StatusHolder, redis *d.RedisInfo, queue *q.BlockProcessorQueue) {
 	headerChan := make(chan *types.Header)
 
-	subs, err := connection.Websocket.SubscribeNewHead(context.Background(), headerChan)
-	if err != nil {
-		log.Fatalf("❗️ Failed to subscribe to block headers : %s\n", err.Error())
+	// Subscribing to block headers, using best websocket endpoint as of now,
+	// if failed, keeps retrying with next best one, after a while
+	subscribe := func() (ethereum.Subscription, *ethclient.Client) {
+
+		for {
+
+			client := connection.Websocket.Get()
+			if client != nil {
+
+				subs, err := client.SubscribeNewHead(context.Background(), headerChan)
+				if err == nil {
+
+					connection.Websocket.Succeeded(client)
+					return subs, client
+
+				}
+
+				log.Printf("❗️ Failed to subscribe to block headers : %s\n", err.Error())
+				connection.Websocket.Disconnected(client)
+
+			}
+
+			<-time.After(time.Duration(1) * time.Second)
+
+		}
+
 	}
+
+	subs, wsClient := subscribe()
 	// Scheduling unsubscribe, to be executed when end of this execution scope is reached
-	defer subs.Unsubscribe()
+	//
+	// Subscription might get replaced in between, so it's closure
+	defer func() {
+		subs.Unsubscribe()
+	}()
 
 	// Flag to check for whether this is first time block header being received or not
 	//
@@ -43,29 +75,47 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 		select {
 		case err := <-subs.Err():
 
-			log.Fatalf("❗️ Listener stopped : %s\n", err.Error())
+			// Subscription is broken, so endpoint is not to be relied upon, until
+			// it passes health check, resubscribing using next best one
+			//
+			// Blocks missed in between, to be taken care of by gap detection 👇
+			if err != nil {
+				log.Printf("❗️ Listener stopped : %s\n", err.Error())
+			}
+
+			subs.Unsubscribe()
+			connection.Websocket.Disconnected(wsClient)
+
+			subs, wsClient = subscribe()
+			log.Printf("✅ Resubscribed to block headers\n")
 
 		case header := <-headerChan:
 
 			// At very beginning iteration, newly mined block number
 			// should be greater than max block number obtained from DB
+			//
+			// If not, either node is lagging behind or chain got reorganized
+			// to a shorter one, while `ette` was offline, which is to be taken
+			// care of by reorg handler 👇
 			if first && !(header.Number.Uint64() > status.MaxBlockNumberAtStartUp()) {
 
-				log.Fatalf("❗️ Bad block received : expected > `%d`\n", status.MaxBlockNumberAtStartUp())
+				log.Printf("❗️ Bad block received %d, expected > %d\n", header.Number.Uint64(), status.MaxBlockNumberAtStartUp())
 
 			}
 
 			// At any iteration other than first one, if received block number
 			// is more than latest block number + 1, it's definite that we've some
-			// block (  >=1 ) missed & the RPC node we're relying on might be feeding us with
-			// wrong data
+			// block (  >=1 ) missed
 			//
-			// It's better stop relying on it, we crash the program
-			// @note This is not the state-of-the art solution, but this is it, as of now
-			// It can be improved.
+			// Those are put into processing queue, so that retry queue manager
+			// can pick them up
 			if !first && header.Number.Uint64() > status.GetLatestBlockNumber()+1 {
 
-				log.Fatalf("❗️ Bad block received %d, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber())
+				log.Printf("❗️ Bad block received %d, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber()+1)
+
+				for i := status.GetLatestBlockNumber() + 1; i < header.Number.Uint64(); i++ {
+					queue.Requeue(i)
+				}
 
 			}
 
@@ -85,6 +135,14 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 			status.SetLatestBlockNumber(header.Number.Uint64())
 			queue.Latest(header.Number.Uint64())
 
+			// Checking whether newly received block builds on top of
+			// what we've in database or not, if not, rolling back to common
+			// ancestor & canonical blocks to be processed again
+			reorged := false
+			if cfg.IsHistorical() {
+				reorged = HandleReorg(connection.RPC.Get(), _db, redis, queue, status, header)
+			}
+
 			if first {
 
 				// Starting now, to be used for calculating system performance, uptime etc.
@@ -93,11 +151,11 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 				// Starting go routine for fetching blocks `ette` failed to process in previous attempt
 				//
 				// Uses Redis backed queue for fetching pending block hash & retries
-				go RetryQueueManager(connection.RPC, _db, redis, queue, status)
+				go RetryQueueManager(connection, _db, redis, queue, status)
 
 				// If historical data query features are enabled
 				// only then we need to sync to latest state of block chain
-				if cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3" {
+				if cfg.IsHistorical() {
 
 					// Starting syncer in another thread, where it'll keep fetching
 					// blocks from highest block number it fetched last time to current network block number
@@ -119,7 +177,7 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 						to = status.MaxBlockNumberAtStartUp() - cfg.GetBlockConfirmations()
 					}
 
-					go SyncBlocksByRange(connection.RPC, _db, redis, queue, from, to, status)
+					go SyncBlocksByRange(connection, _db, redis, queue, from, to, status)
 
 				}
 				// Making sure that when next latest block header is received, it'll not
@@ -144,7 +202,7 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 				// no need to check what's present in unfinalized block number queue
 				// because no finality feature is provided for blocks on websocket based
 				// real-time subscription mechanism
-				if cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3" {
+				if cfg.IsHistorical() {
 
 					// Next block which can be attempted to be checked
 					// while finally considering it confirmed & put into DB
@@ -160,13 +218,17 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 
 							wp.Submit(func() {
 
-								if !FetchBlockByNumber(connection.RPC, _oldestBlock, _db, redis, false, queue, status) {
+								client := connection.RPC.Get()
+
+								if !FetchBlockByNumber(client, _oldestBlock, _db, redis, false, queue, status) {
 
+									connection.RPC.Failed(client)
 									_queue.ConfirmedFailed(_oldestBlock)
 									return
 
 								}
 
+								connection.RPC.Succeeded(client)
 								_queue.ConfirmedDone(_oldestBlock)
 
 							})
@@ -177,19 +239,28 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 
 				}
 
+				// Already put into processing queue by reorg handler
+				if reorged {
+					return
+				}
+
 				wp.Submit(func() {
 
 					if !_queue.Put(blockNumber) {
 						return
 					}
 
-					if !FetchBlockByHash(connection.RPC, blockHash, fmt.Sprintf("%d", blockNumber), _db, redis, queue, status) {
+					client := connection.RPC.Get()
+
+					if !FetchBlockByHash(client, blockHash, fmt.Sprintf("%d", blockNumber), _db, redis, queue, status) {
 
+						connection.RPC.Failed(client)
 						_queue.UnconfirmedFailed(blockNumber)
 						return
 
 					}
 
+					connection.RPC.Succeeded(client)
 					_queue.UnconfirmedDone(blockNumber)
 
 				})
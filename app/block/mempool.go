@@ -0,0 +1,606 @@
+package block
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gammazero/workerpool"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+const (
+	// MaxTrackedPendingTxs - Max number of pending tx(s) to be kept track of,
+	// for sending follow ups, pending tx(s) seen after that, are only published
+	MaxTrackedPendingTxs = 50000
+	// PendingTxCheckInterval - Pending tx(s), not mined in this much time,
+	// are checked with blockchain node, whether they're still in mempool or not
+	PendingTxCheckInterval = time.Duration(5) * time.Minute
+)
+
+// pendingTx - Identifying fields of pending tx, being tracked until it
+// gets mined/ replaced/ dropped
+type pendingTx struct {
+	hash      string
+	from      string
+	to        string
+	nonce     uint64
+	checkedAt time.Time
+}
+
+// followUp - Builds follow up message, to be published for this pending tx
+func (p *pendingTx) followUp(status string) *d.PendingTransaction {
+	return &d.PendingTransaction{
+		Status: status,
+		Hash:   p.hash,
+		From:   p.from,
+		To:     p.to,
+		Nonce:  p.nonce,
+	}
+}
+
+// pendingTxTracker - Keeps track of published pending tx(s), indexed by
+// tx hash & by sender nonce, so that replacements can be figured out
+type pendingTxTracker struct {
+	lock     sync.Mutex
+	byHash   map[string]*pendingTx
+	bySender map[string][]string
+}
+
+// tracker - Pending tx(s) being tracked by this `ette` instance
+var tracker = &pendingTxTracker{
+	byHash:   make(map[string]*pendingTx),
+	bySender: make(map[string][]string),
+}
+
+// senderKey - Tx(s) sent from same account, with same nonce, are replacements
+// of each other, only one of them can be mined
+func senderKey(from string, nonce uint64) string {
+	return fmt.Sprintf("%s/%d", from, nonce)
+}
+
+// track - Starts tracking pending tx, returns false if it's already being
+// tracked i.e. it's already been published
+func (p *pendingTxTracker) track(tx *pendingTx) bool {
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.byHash[tx.hash]; ok {
+		return false
+	}
+
+	if len(p.byHash) >= MaxTrackedPendingTxs {
+		return true
+	}
+
+	key := senderKey(tx.from, tx.nonce)
+
+	p.byHash[tx.hash] = tx
+	p.bySender[key] = append(p.bySender[key], tx.hash)
+
+	return true
+
+}
+
+// remove - Stops tracking pending tx, to be invoked with lock held
+func (p *pendingTxTracker) remove(hash string) *pendingTx {
+
+	tx, ok := p.byHash[hash]
+	if !ok {
+		return nil
+	}
+
+	delete(p.byHash, hash)
+
+	key := senderKey(tx.from, tx.nonce)
+	hashes := p.bySender[key]
+
+	for k, v := range hashes {
+
+		if v == hash {
+			hashes = append(hashes[:k], hashes[k+1:]...)
+			break
+		}
+
+	}
+
+	if len(hashes) == 0 {
+		delete(p.bySender, key)
+	} else {
+		p.bySender[key] = hashes
+	}
+
+	return tx
+
+}
+
+// untrack - Stops tracking pending tx, returns nil if it was not being tracked
+func (p *pendingTxTracker) untrack(hash string) *pendingTx {
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.remove(hash)
+
+}
+
+// mined - Stops tracking mined tx & all other tx(s) sent from same account
+// with same nonce, returning mined one ( if was being tracked ) & replaced ones
+func (p *pendingTxTracker) mined(hash string, from string, nonce uint64) (*pendingTx, []*pendingTx) {
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.byHash) == 0 {
+		return nil, nil
+	}
+
+	mined := p.remove(hash)
+
+	hashes := p.bySender[senderKey(from, nonce)]
+	replaced := make([]*pendingTx, 0, len(hashes))
+
+	for _, v := range append([]string{}, hashes...) {
+		replaced = append(replaced, p.remove(v))
+	}
+
+	return mined, replaced
+
+}
+
+// stale - Returns pending tx(s), which are not checked with blockchain
+// node in a while, while marking them as checked now
+func (p *pendingTxTracker) stale() []*pendingTx {
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now().UTC()
+	txs := make([]*pendingTx, 0)
+
+	for _, v := range p.byHash {
+
+		if now.Sub(v.checkedAt) < PendingTxCheckInterval {
+			continue
+		}
+
+		v.checkedAt = now
+		txs = append(txs, v)
+
+	}
+
+	return txs
+
+}
+
+// rpcPendingTx - Tx as returned by `eth_getTransactionByHash`, along with
+// fields which are not part of signed tx body
+type rpcPendingTx struct {
+	tx    *types.Transaction
+	extra struct {
+		From        *common.Address `json:"from"`
+		BlockHash   *common.Hash    `json:"blockHash"`
+		BlockNumber *hexutil.Big    `json:"blockNumber"`
+	}
+}
+
+// UnmarshalJSON - Decoding tx body & extra fields, from same JSON object
+func (r *rpcPendingTx) UnmarshalJSON(msg []byte) error {
+
+	if err := json.Unmarshal(msg, &r.tx); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(msg, &r.extra)
+
+}
+
+// fetchPendingTransactions - Fetches tx(s) by hash, using JSON-RPC batch requests
+// if node supports it, otherwise one by one. Tx(s) not known to node, are nil
+func fetchPendingTransactions(raw *rpc.Client, hashes []common.Hash) ([]*rpcPendingTx, error) {
+
+	size := int(cfg.GetBatchSize())
+	if size == 0 || !isSupported(raw, "batch") {
+		size = 1
+	}
+
+	txs := make([]*rpcPendingTx, len(hashes))
+
+	for i := 0; i < len(hashes); i += size {
+
+		till := i + size
+		if till > len(hashes) {
+			till = len(hashes)
+		}
+
+		if size == 1 {
+
+			start := time.Now()
+
+			err := raw.CallContext(context.Background(), &txs[i], "eth_getTransactionByHash", hashes[i])
+			metrics.ObserveRPC("eth_getTransactionByHash", start, err)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+
+		}
+
+		batch := make([]rpc.BatchElem, 0, till-i)
+
+		for j := i; j < till; j++ {
+
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getTransactionByHash",
+				Args:   []interface{}{hashes[j]},
+				Result: &txs[j],
+			})
+
+		}
+
+		start := time.Now()
+
+		err := raw.BatchCallContext(context.Background(), batch)
+		metrics.ObserveRPC("batch/eth_getTransactionByHash", start, err)
+		if err != nil {
+
+			markUnsupported(raw, "batch", err)
+			return nil, err
+
+		}
+
+		for _, v := range batch {
+
+			if v.Error != nil {
+				return nil, v.Error
+			}
+
+		}
+
+	}
+
+	return txs, nil
+
+}
+
+// buildPendingTransaction - Given pending tx, as received from blockchain node,
+// builds message to be published, while deriving sender locally, if not present
+func buildPendingTransaction(r *rpcPendingTx) (*d.PendingTransaction, error) {
+
+	tx := r.tx
+
+	var sender common.Address
+
+	if r.extra.From != nil {
+		sender = *r.extra.From
+	} else {
+
+		_sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return nil, err
+		}
+
+		sender = _sender
+
+	}
+
+	pending := &d.PendingTransaction{
+		Status:   d.PendingStatus,
+		Hash:     tx.Hash().Hex(),
+		From:     sender.Hex(),
+		Nonce:    tx.Nonce(),
+		Type:     tx.Type(),
+		Value:    tx.Value().String(),
+		Gas:      tx.Gas(),
+		GasPrice: tx.GasPrice().String(),
+	}
+
+	if tx.To() != nil {
+		pending.To = tx.To().Hex()
+	}
+
+	if _h := hex.EncodeToString(tx.Data()); _h != "" {
+		pending.Data = fmt.Sprintf("0x%s", _h)
+	}
+
+	// Fee caps are only present in EIP-1559 style tx(s) & later ones
+	if tx.Type() >= types.DynamicFeeTxType {
+		pending.MaxFeePerGas = tx.GasFeeCap().String()
+		pending.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
+
+	return pending, nil
+
+}
+
+// publishPendingTransactions - Fetches bodies of pending tx(s), whose hashes were
+// announced by blockchain node & publishes those which are not yet seen
+func publishPendingTransactions(connection *d.BlockChainNodeConnection, hashes []common.Hash, redis *d.RedisInfo) {
+
+	client := connection.RPC.Get()
+	if client == nil {
+
+		log.Printf("❗️ Failed to fetch %d pending tx(s) : no healthy blockchain node\n", len(hashes))
+		return
+
+	}
+
+	txs, err := fetchPendingTransactions(client.Client(), hashes)
+	if err != nil {
+
+		log.Printf("❗️ Failed to fetch %d pending tx(s) : %s\n", len(hashes), err.Error())
+		connection.RPC.Failed(client)
+		return
+
+	}
+
+	connection.RPC.Succeeded(client)
+
+	for _, v := range txs {
+
+		// Not known to node anymore or already mined, by the time
+		// we asked for it
+		if v == nil || v.tx == nil || v.extra.BlockHash != nil {
+			continue
+		}
+
+		pending, err := buildPendingTransaction(v)
+		if err != nil {
+
+			log.Printf("❗️ Failed to derive sender of pending tx %s : %s\n", v.tx.Hash().Hex(), err.Error())
+			continue
+
+		}
+
+		if !tracker.track(&pendingTx{
+			hash:      pending.Hash,
+			from:      pending.From,
+			to:        pending.To,
+			nonce:     pending.Nonce,
+			checkedAt: time.Now().UTC(),
+		}) {
+			continue
+		}
+
+		PublishPendingTransaction(pending, redis)
+
+	}
+
+}
+
+// sweepPendingTransactions - Checks with blockchain node, whether pending tx(s),
+// not mined in a while, are still in mempool or not. Those not known to node
+// anymore, are announced to be dropped
+//
+// Tx(s) found to be mined, which we've missed somehow, are announced
+// to be mined
+func sweepPendingTransactions(connection *d.BlockChainNodeConnection, redis *d.RedisInfo) {
+
+	stale := tracker.stale()
+	if len(stale) == 0 {
+		return
+	}
+
+	client := connection.RPC.Get()
+	if client == nil {
+		return
+	}
+
+	hashes := make([]common.Hash, len(stale))
+	for k, v := range stale {
+		hashes[k] = common.HexToHash(v.hash)
+	}
+
+	txs, err := fetchPendingTransactions(client.Client(), hashes)
+	if err != nil {
+
+		log.Printf("❗️ Failed to check %d pending tx(s) : %s\n", len(hashes), err.Error())
+		connection.RPC.Failed(client)
+		return
+
+	}
+
+	connection.RPC.Succeeded(client)
+
+	for k, v := range txs {
+
+		// Still in mempool
+		if v != nil && v.extra.BlockHash == nil {
+			continue
+		}
+
+		tx := tracker.untrack(stale[k].hash)
+		if tx == nil {
+			continue
+		}
+
+		if v == nil {
+
+			PublishPendingTransaction(tx.followUp(d.DroppedStatus), redis)
+			continue
+
+		}
+
+		mined := tx.followUp(d.MinedStatus)
+		mined.BlockHash = v.extra.BlockHash.Hex()
+		if v.extra.BlockNumber != nil {
+			mined.BlockNumber = v.extra.BlockNumber.ToInt().Uint64()
+		}
+
+		PublishPendingTransaction(mined, redis)
+
+	}
+
+}
+
+// NotifyMinedTransaction - When tx gets mined, if it was being tracked as pending one,
+// follow up is published, along with for all other tracked tx(s) sent from same account
+// with same nonce, which are now replaced by this one
+//
+// Failing to publish follow up doesn't fail block processing
+func NotifyMinedTransaction(blockNumber uint64, tx *db.Transactions, redis *d.RedisInfo) {
+
+	mined, replaced := tracker.mined(tx.Hash, tx.From, tx.Nonce)
+
+	if mined != nil {
+
+		_mined := mined.followUp(d.MinedStatus)
+		_mined.BlockHash = tx.BlockHash
+		_mined.BlockNumber = blockNumber
+
+		PublishPendingTransaction(_mined, redis)
+
+	}
+
+	for _, v := range replaced {
+
+		_replaced := v.followUp(d.ReplacedStatus)
+		_replaced.BlockHash = tx.BlockHash
+		_replaced.BlockNumber = blockNumber
+		_replaced.ReplacedBy = tx.Hash
+
+		PublishPendingTransaction(_replaced, redis)
+
+	}
+
+}
+
+// SubscribeToPendingTransactions - Listens for hashes of pending tx(s), as they're
+// announced by blockchain node, fetches their bodies in batches & publishes them on
+// `pending` topic, followed by mined/ replaced/ dropped notification
+func SubscribeToPendingTransactions(connection *d.BlockChainNodeConnection, redis *d.RedisInfo) {
+
+	hashChan := make(chan common.Hash, 1024)
+
+	// Subscribing to pending tx hashes, using best websocket endpoint as of now,
+	// if failed, keeps retrying with next best one, after a while
+	//
+	// If node says it doesn't support it, gives up
+	subscribe := func() (*rpc.ClientSubscription, *ethclient.Client) {
+
+		for {
+
+			client := connection.Websocket.Get()
+			if client != nil {
+
+				subs, err := client.Client().EthSubscribe(context.Background(), hashChan, "newPendingTransactions")
+				if err == nil {
+
+					connection.Websocket.Succeeded(client)
+					return subs, client
+
+				}
+
+				log.Printf("❗️ Failed to subscribe to pending tx(s) : %s\n", err.Error())
+
+				markUnsupported(client.Client(), "newPendingTransactions", err)
+				if !isSupported(client.Client(), "newPendingTransactions") {
+					return nil, nil
+				}
+
+				connection.Websocket.Failed(client)
+
+			}
+
+			<-time.After(time.Duration(1) * time.Second)
+
+		}
+
+	}
+
+	subs, wsClient := subscribe()
+	if subs == nil {
+		return
+	}
+
+	// Subscription might get replaced in between, so it's closure
+	defer func() {
+		subs.Unsubscribe()
+	}()
+
+	size := int(cfg.GetBatchSize())
+	if size == 0 {
+		size = 100
+	}
+
+	// Announced hashes are buffered, so that bodies can be fetched in batches
+	buffer := make([]common.Hash, 0, size)
+
+	flushTicker := time.NewTicker(time.Duration(200) * time.Millisecond)
+	defer flushTicker.Stop()
+
+	sweepTicker := time.NewTicker(time.Duration(1) * time.Minute)
+	defer sweepTicker.Stop()
+
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+	defer wp.Stop()
+
+	flush := func() {
+
+		if len(buffer) == 0 {
+			return
+		}
+
+		hashes := buffer
+		buffer = make([]common.Hash, 0, size)
+
+		wp.Submit(func() {
+			publishPendingTransactions(connection, hashes, redis)
+		})
+
+	}
+
+	for {
+		select {
+		case err := <-subs.Err():
+
+			if err != nil {
+				log.Printf("❗️ Pending tx listener stopped : %s\n", err.Error())
+			}
+
+			subs.Unsubscribe()
+			connection.Websocket.Disconnected(wsClient)
+
+			subs, wsClient = subscribe()
+			if subs == nil {
+				return
+			}
+
+			log.Printf("✅ Resubscribed to pending tx(s)\n")
+
+		case hash := <-hashChan:
+
+			buffer = append(buffer, hash)
+			if len(buffer) >= size {
+				flush()
+			}
+
+		case <-flushTicker.C:
+
+			flush()
+
+		case <-sweepTicker.C:
+
+			wp.Submit(func() {
+				sweepPendingTransactions(connection, redis)
+			})
+
+		}
+	}
+
+}
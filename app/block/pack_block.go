@@ -1,8 +1,12 @@
 package block
 
 import (
+	"encoding/json"
+	"log"
+
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 )
 
@@ -28,9 +32,58 @@ func BuildPackedBlock(block *types.Block, txs []*db.PackedTransaction) *db.Packe
 		TransactionRootHash: block.TxHash().Hex(),
 		ReceiptRootHash:     block.ReceiptHash().Hex(),
 		ExtraData:           block.Extra(),
+		Withdrawals:         encodeWithdrawals(block),
+	}
+
+	// Present only post London fork
+	if block.BaseFee() != nil {
+		packedBlock.Block.BaseFee = block.BaseFee().String()
+	}
+
+	// Present only post Cancun fork
+	if block.BlobGasUsed() != nil {
+		packedBlock.Block.BlobGasUsed = *block.BlobGasUsed()
+	}
+
+	if block.ExcessBlobGas() != nil {
+		packedBlock.Block.ExcessBlobGas = *block.ExcessBlobGas()
 	}
+
 	packedBlock.Transactions = txs
 
 	return packedBlock
 
 }
+
+// encodeWithdrawals - JSON encodes validator withdrawals present in block,
+// returns nil, for blocks prior to Shanghai fork
+func encodeWithdrawals(block *types.Block) []byte {
+
+	if block.Withdrawals() == nil {
+		return nil
+	}
+
+	withdrawals := make([]*d.Withdrawal, 0, len(block.Withdrawals()))
+
+	for _, v := range block.Withdrawals() {
+
+		withdrawals = append(withdrawals, &d.Withdrawal{
+			Index:     v.Index,
+			Validator: v.Validator,
+			Address:   v.Address.Hex(),
+			Amount:    v.Amount,
+		})
+
+	}
+
+	data, err := json.Marshal(withdrawals)
+	if err != nil {
+
+		log.Printf("[!] Failed to encode withdrawals of block %d : %s\n", block.NumberU64(), err.Error())
+		return nil
+
+	}
+
+	return data
+
+}
@@ -0,0 +1,179 @@
+package block
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+const (
+	// TransferTopic - keccak256("Transfer(address,address,uint256)"), emitted
+	// by both ERC-20 & ERC-721 tokens
+	TransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	// TransferSingleTopic - keccak256("TransferSingle(address,address,address,uint256,uint256)"),
+	// emitted by ERC-1155 tokens
+	TransferSingleTopic = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+	// TransferBatchTopic - keccak256("TransferBatch(address,address,address,uint256[],uint256[])"),
+	// emitted by ERC-1155 tokens
+	TransferBatchTopic = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+)
+
+// topicToAddress - Indexed address parameters are left padded to 32 bytes
+// in event topics, extracting address out of it
+func topicToAddress(topic string) string {
+	return common.BytesToAddress(common.HexToHash(topic).Bytes()).Hex()
+}
+
+// wordAt - Reads `index`-th 32 bytes word from ABI encoded data, as unsigned integer
+func wordAt(data []byte, index uint64) (*big.Int, bool) {
+
+	if index >= uint64(len(data))/32 {
+		return nil, false
+	}
+
+	return new(big.Int).SetBytes(data[index*32 : (index+1)*32]), true
+
+}
+
+// uint256ArrayAt - Reads dynamic array of uint256, whose offset is
+// encoded as `index`-th word of ABI encoded data
+func uint256ArrayAt(data []byte, index uint64) ([]*big.Int, bool) {
+
+	offset, ok := wordAt(data, index)
+	if !ok || !offset.IsUint64() || offset.Uint64()%32 != 0 {
+		return nil, false
+	}
+
+	start := offset.Uint64() / 32
+
+	length, ok := wordAt(data, start)
+	if !ok || !length.IsUint64() || length.Uint64() > uint64(len(data))/32 {
+		return nil, false
+	}
+
+	values := make([]*big.Int, length.Uint64())
+
+	for i := uint64(0); i < length.Uint64(); i++ {
+
+		value, ok := wordAt(data, start+1+i)
+		if !ok {
+			return nil, false
+		}
+
+		values[i] = value
+
+	}
+
+	return values, true
+
+}
+
+// BuildTokenTransfers - Decodes standard token transfer event log into
+// token transfer(s), while returning nil for all other event logs
+//
+// ERC-20 & ERC-721 both emit `Transfer` event with same signature, they're
+// distinguished by number of indexed parameters i.e. ERC-721 has token id indexed
+func BuildTokenTransfers(event *db.Events) []*db.TokenTransfers {
+
+	if event == nil || len(event.Topics) == 0 {
+		return nil
+	}
+
+	transfer := func(standard string, from string, to string, tokenID string, amount string, batchIndex uint) *db.TokenTransfers {
+		return &db.TokenTransfers{
+			BlockHash:       event.BlockHash,
+			Index:           event.Index,
+			BatchIndex:      batchIndex,
+			Token:           event.Origin,
+			Standard:        standard,
+			From:            from,
+			To:              to,
+			TokenID:         tokenID,
+			Amount:          amount,
+			TransactionHash: event.TransactionHash,
+		}
+	}
+
+	switch event.Topics[0] {
+
+	case TransferTopic:
+
+		// ERC-20 : Transfer(address indexed from, address indexed to, uint256 value)
+		if len(event.Topics) == 3 && len(event.Data) == 32 {
+
+			value, _ := wordAt(event.Data, 0)
+
+			return []*db.TokenTransfers{
+				transfer("erc20", topicToAddress(event.Topics[1]), topicToAddress(event.Topics[2]), "", value.String(), 0),
+			}
+
+		}
+
+		// ERC-721 : Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+		if len(event.Topics) == 4 && len(event.Data) == 0 {
+
+			return []*db.TokenTransfers{
+				transfer("erc721", topicToAddress(event.Topics[1]), topicToAddress(event.Topics[2]), common.HexToHash(event.Topics[3]).Big().String(), "1", 0),
+			}
+
+		}
+
+	case TransferSingleTopic:
+
+		// ERC-1155 : TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+		if len(event.Topics) != 4 || len(event.Data) != 64 {
+			return nil
+		}
+
+		id, _ := wordAt(event.Data, 0)
+		value, _ := wordAt(event.Data, 1)
+
+		return []*db.TokenTransfers{
+			transfer("erc1155", topicToAddress(event.Topics[2]), topicToAddress(event.Topics[3]), id.String(), value.String(), 0),
+		}
+
+	case TransferBatchTopic:
+
+		// ERC-1155 : TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+		if len(event.Topics) != 4 {
+			return nil
+		}
+
+		ids, ok := uint256ArrayAt(event.Data, 0)
+		if !ok {
+			return nil
+		}
+
+		values, ok := uint256ArrayAt(event.Data, 1)
+		if !ok || len(ids) != len(values) {
+			return nil
+		}
+
+		transfers := make([]*db.TokenTransfers, len(ids))
+
+		for k := range ids {
+			transfers[k] = transfer("erc1155", topicToAddress(event.Topics[2]), topicToAddress(event.Topics[3]), ids[k].String(), values[k].String(), uint(k))
+		}
+
+		return transfers
+
+	}
+
+	return nil
+
+}
+
+// BuildPackedTokenTransfers - Decodes all token transfers, found in
+// event logs emitted by single tx
+func BuildPackedTokenTransfers(events []*db.Events) []*db.TokenTransfers {
+
+	transfers := make([]*db.TokenTransfers, 0)
+
+	for _, v := range events {
+		transfers = append(transfers, BuildTokenTransfers(v)...)
+	}
+
+	return transfers
+
+}
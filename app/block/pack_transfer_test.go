@@ -0,0 +1,220 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// words - ABI encodes given unsigned integers, as 32 bytes words, one after another
+func words(values ...uint64) []byte {
+
+	data := make([]byte, 0, len(values)*32)
+	for _, v := range values {
+		data = append(data, common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32)...)
+	}
+
+	return data
+
+}
+
+func TestUint256ArrayAt(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		data   []byte
+		index  uint64
+		values []uint64
+		ok     bool
+	}{
+		{
+			name:   "first array",
+			data:   words(64, 160, 2, 7, 8, 2, 9, 10),
+			index:  0,
+			values: []uint64{7, 8},
+			ok:     true,
+		},
+		{
+			name:   "second array",
+			data:   words(64, 160, 2, 7, 8, 2, 9, 10),
+			index:  1,
+			values: []uint64{9, 10},
+			ok:     true,
+		},
+		{
+			name:   "empty array",
+			data:   words(32, 0),
+			index:  0,
+			values: []uint64{},
+			ok:     true,
+		},
+		{
+			name:  "offset word missing",
+			data:  words(32, 0),
+			index: 2,
+		},
+		{
+			name:  "offset not word aligned",
+			data:  words(33, 1, 7),
+			index: 0,
+		},
+		{
+			name:  "offset out of data",
+			data:  words(1024),
+			index: 0,
+		},
+		{
+			name:  "length longer than data",
+			data:  words(32, 1000, 7),
+			index: 0,
+		},
+		{
+			name:  "elements cut short",
+			data:  words(32, 3, 7, 8),
+			index: 0,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			values, ok := uint256ArrayAt(tt.data, tt.index)
+			if ok != tt.ok {
+				t.Fatalf("expected ok to be %t, got %t", tt.ok, ok)
+			}
+
+			if len(values) != len(tt.values) {
+				t.Fatalf("expected %d value(s), got %d", len(tt.values), len(values))
+			}
+
+			for k, v := range values {
+
+				if v.Uint64() != tt.values[k] {
+					t.Errorf("expected %d at %d, got %s", tt.values[k], k, v.String())
+				}
+
+			}
+
+		})
+
+	}
+
+}
+
+func TestBuildTokenTransfers(t *testing.T) {
+
+	var (
+		operator = common.HexToHash("0x1").Hex()
+		from     = common.HexToHash("0x2").Hex()
+		to       = common.HexToHash("0x3").Hex()
+	)
+
+	tests := []struct {
+		name      string
+		event     *db.Events
+		transfers []*db.TokenTransfers
+	}{
+		{
+			name: "erc20",
+			event: &db.Events{
+				Topics: []string{TransferTopic, from, to},
+				Data:   words(100),
+			},
+			transfers: []*db.TokenTransfers{
+				{Standard: "erc20", From: topicToAddress(from), To: topicToAddress(to), Amount: "100"},
+			},
+		},
+		{
+			name: "erc721",
+			event: &db.Events{
+				Topics: []string{TransferTopic, from, to, common.HexToHash("0x2a").Hex()},
+			},
+			transfers: []*db.TokenTransfers{
+				{Standard: "erc721", From: topicToAddress(from), To: topicToAddress(to), TokenID: "42", Amount: "1"},
+			},
+		},
+		{
+			name: "erc1155 single",
+			event: &db.Events{
+				Topics: []string{TransferSingleTopic, operator, from, to},
+				Data:   words(5, 10),
+			},
+			transfers: []*db.TokenTransfers{
+				{Standard: "erc1155", From: topicToAddress(from), To: topicToAddress(to), TokenID: "5", Amount: "10"},
+			},
+		},
+		{
+			name: "erc1155 batch",
+			event: &db.Events{
+				Topics: []string{TransferBatchTopic, operator, from, to},
+				Data:   words(64, 160, 2, 1, 2, 2, 30, 40),
+			},
+			transfers: []*db.TokenTransfers{
+				{Standard: "erc1155", From: topicToAddress(from), To: topicToAddress(to), TokenID: "1", Amount: "30", BatchIndex: 0},
+				{Standard: "erc1155", From: topicToAddress(from), To: topicToAddress(to), TokenID: "2", Amount: "40", BatchIndex: 1},
+			},
+		},
+		{
+			name: "erc1155 batch with mismatching lengths",
+			event: &db.Events{
+				Topics: []string{TransferBatchTopic, operator, from, to},
+				Data:   words(64, 160, 2, 1, 2, 1, 30),
+			},
+		},
+		{
+			name: "erc20 with bad data",
+			event: &db.Events{
+				Topics: []string{TransferTopic, from, to},
+				Data:   words(1, 2),
+			},
+		},
+		{
+			name: "erc1155 single with missing topic",
+			event: &db.Events{
+				Topics: []string{TransferSingleTopic, from, to},
+				Data:   words(5, 10),
+			},
+		},
+		{
+			name: "other event",
+			event: &db.Events{
+				Topics: []string{common.HexToHash("0x1234").Hex(), from, to},
+				Data:   words(100),
+			},
+		},
+		{
+			name:  "no topics",
+			event: &db.Events{},
+		},
+		{
+			name: "nil event",
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			transfers := BuildTokenTransfers(tt.event)
+			if len(transfers) != len(tt.transfers) {
+				t.Fatalf("expected %d transfer(s), got %d", len(tt.transfers), len(transfers))
+			}
+
+			for k, v := range transfers {
+
+				want := tt.transfers[k]
+
+				if v.Standard != want.Standard || v.From != want.From || v.To != want.To || v.TokenID != want.TokenID || v.Amount != want.Amount || v.BatchIndex != want.BatchIndex {
+					t.Errorf("expected %+v at %d, got %+v", want, k, v)
+				}
+
+			}
+
+		})
+
+	}
+
+}
@@ -1,9 +1,13 @@
 package block
 
 import (
+	"encoding/json"
+	"log"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	c "github.com/itzmeanjan/ette/app/common"
+	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 )
 
@@ -13,40 +17,50 @@ func BuildPackedTx(tx *types.Transaction, sender common.Address, receipt *types.
 
 	packedTx := &db.PackedTransaction{}
 
+	// Kept along with tx, so that it can be found in right partition
+	var blockNumber uint64
+	if receipt.BlockNumber != nil {
+		blockNumber = receipt.BlockNumber.Uint64()
+	}
+
 	if tx.To() == nil {
 
 		packedTx.Tx = &db.Transactions{
-			Hash:      tx.Hash().Hex(),
-			From:      sender.Hex(),
-			Contract:  receipt.ContractAddress.Hex(),
-			Value:     tx.Value().String(),
-			Data:      tx.Data(),
-			Gas:       tx.Gas(),
-			GasPrice:  tx.GasPrice().String(),
-			Cost:      tx.Cost().String(),
-			Nonce:     tx.Nonce(),
-			State:     receipt.Status,
-			BlockHash: receipt.BlockHash.Hex(),
+			Hash:        tx.Hash().Hex(),
+			From:        sender.Hex(),
+			Contract:    receipt.ContractAddress.Hex(),
+			Value:       tx.Value().String(),
+			Data:        tx.Data(),
+			Gas:         tx.Gas(),
+			GasPrice:    tx.GasPrice().String(),
+			Cost:        tx.Cost().String(),
+			Nonce:       tx.Nonce(),
+			State:       receipt.Status,
+			BlockHash:   receipt.BlockHash.Hex(),
+			BlockNumber: blockNumber,
 		}
 
 	} else {
 
 		packedTx.Tx = &db.Transactions{
-			Hash:      tx.Hash().Hex(),
-			From:      sender.Hex(),
-			To:        tx.To().Hex(),
-			Value:     tx.Value().String(),
-			Data:      tx.Data(),
-			Gas:       tx.Gas(),
-			GasPrice:  tx.GasPrice().String(),
-			Cost:      tx.Cost().String(),
-			Nonce:     tx.Nonce(),
-			State:     receipt.Status,
-			BlockHash: receipt.BlockHash.Hex(),
+			Hash:        tx.Hash().Hex(),
+			From:        sender.Hex(),
+			To:          tx.To().Hex(),
+			Value:       tx.Value().String(),
+			Data:        tx.Data(),
+			Gas:         tx.Gas(),
+			GasPrice:    tx.GasPrice().String(),
+			Cost:        tx.Cost().String(),
+			Nonce:       tx.Nonce(),
+			State:       receipt.Status,
+			BlockHash:   receipt.BlockHash.Hex(),
+			BlockNumber: blockNumber,
 		}
 
 	}
 
+	setTypedTxFields(packedTx.Tx, tx, receipt)
+
 	packedTx.Events = make([]*db.Events, len(receipt.Logs))
 
 	for k, v := range receipt.Logs {
@@ -58,10 +72,85 @@ func BuildPackedTx(tx *types.Transaction, sender common.Address, receipt *types.
 			Data:            v.Data,
 			TransactionHash: v.TxHash.Hex(),
 			BlockHash:       v.BlockHash.Hex(),
+			BlockNumber:     v.BlockNumber,
 		}
 
 	}
 
+	packedTx.TokenTransfers = BuildPackedTokenTransfers(packedTx.Events)
+
 	return packedTx
 
 }
+
+// setTypedTxFields - Sets fields introduced with typed tx(s) i.e. EIP-2930 access
+// list, EIP-1559 fee caps & EIP-4844 blobs, along with gas actually used & price paid
+// for it, as found in receipt
+//
+// Fields not applicable for this tx type, are left empty
+func setTypedTxFields(_tx *db.Transactions, tx *types.Transaction, receipt *types.Receipt) {
+
+	_tx.Type = tx.Type()
+	_tx.GasUsed = receipt.GasUsed
+
+	if receipt.EffectiveGasPrice != nil {
+		_tx.EffectiveGasPrice = receipt.EffectiveGasPrice.String()
+	}
+
+	if tx.Type() >= types.DynamicFeeTxType {
+		_tx.MaxFeePerGas = tx.GasFeeCap().String()
+		_tx.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
+
+	if tx.Type() >= types.AccessListTxType {
+		_tx.AccessList = encodeAccessList(tx)
+	}
+
+	if tx.Type() == types.BlobTxType {
+
+		_tx.BlobGas = tx.BlobGas()
+		_tx.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+
+		if receipt.BlobGasPrice != nil {
+			_tx.BlobGasPrice = receipt.BlobGasPrice.String()
+		}
+
+		_tx.BlobHashes = make([]string, 0, len(tx.BlobHashes()))
+		for _, v := range tx.BlobHashes() {
+			_tx.BlobHashes = append(_tx.BlobHashes, v.Hex())
+		}
+
+	}
+
+}
+
+// encodeAccessList - JSON encodes access list of tx
+func encodeAccessList(tx *types.Transaction) []byte {
+
+	accessList := make([]*d.AccessTuple, 0, len(tx.AccessList()))
+
+	for _, v := range tx.AccessList() {
+
+		keys := make([]string, 0, len(v.StorageKeys))
+		for _, k := range v.StorageKeys {
+			keys = append(keys, k.Hex())
+		}
+
+		accessList = append(accessList, &d.AccessTuple{
+			Address:     v.Address.Hex(),
+			StorageKeys: keys,
+		})
+
+	}
+
+	data, err := json.Marshal(accessList)
+	if err != nil {
+
+		log.Printf("[!] Failed to encode access list of tx %s : %s\n", tx.Hash().Hex(), err.Error())
+		return nil
+
+	}
+
+	return data
+
+}
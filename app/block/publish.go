@@ -0,0 +1,28 @@
+package block
+
+import (
+	"encoding"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+// publish - Publishes data on topic, using broker, which fans it out
+// to all subscribers, while counting failed attempts
+func publish(redis *d.RedisInfo, topic string, data encoding.BinaryMarshaler) error {
+
+	_data, err := data.MarshalBinary()
+	if err != nil {
+		metrics.BrokerPublishFailed(cfg.GetBroker(), topic)
+		return err
+	}
+
+	if err := redis.Broker.Publish(topic, _data); err != nil {
+		metrics.BrokerPublishFailed(cfg.GetBroker(), topic)
+		return err
+	}
+
+	return nil
+
+}
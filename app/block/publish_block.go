@@ -1,14 +1,13 @@
 package block
 
 import (
-	"context"
 	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 )
 
-// PublishBlock - Attempts to publish block data to Redis pubsub channel
+// PublishBlock - Attempts to publish block data on broker topic
 func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
 
 	if block == nil {
@@ -31,9 +30,13 @@ func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
 		TransactionRootHash: block.Block.TransactionRootHash,
 		ReceiptRootHash:     block.Block.ReceiptRootHash,
 		ExtraData:           block.Block.ExtraData,
+		BaseFee:             block.Block.BaseFee,
+		BlobGasUsed:         block.Block.BlobGasUsed,
+		ExcessBlobGas:       block.Block.ExcessBlobGas,
+		Withdrawals:         block.Block.Withdrawals,
 	}
 
-	if err := redis.Client.Publish(context.Background(), redis.BlockPublishTopic, _block).Err(); err != nil {
+	if err := publish(redis, redis.BlockPublishTopic, _block); err != nil {
 
 		log.Printf("❗️ Failed to publish block %d : %s\n", block.Block.Number, err.Error())
 		return false
@@ -1,7 +1,6 @@
 package block
 
 import (
-	"context"
 	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
@@ -9,7 +8,7 @@ import (
 )
 
 // PublishEvents - Iterate over all events & try to publish them on
-// redis pubsub channel
+// broker topic
 func PublishEvents(blockNumber uint64, events []*db.Events, redis *d.RedisInfo) bool {
 
 	if events == nil {
@@ -31,7 +30,7 @@ func PublishEvents(blockNumber uint64, events []*db.Events, redis *d.RedisInfo)
 
 }
 
-// PublishEvent - Publishing event/ log entry to redis pub-sub topic, to be captured by subscribers
+// PublishEvent - Publishing event/ log entry on broker topic, to be captured by subscribers
 // and sent to client application, who are interested in this piece of data
 // after applying filter
 func PublishEvent(blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool {
@@ -49,7 +48,7 @@ func PublishEvent(blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool
 		BlockHash:       event.BlockHash,
 	}
 
-	if err := redis.Client.Publish(context.Background(), redis.EventPublishTopic, data).Err(); err != nil {
+	if err := publish(redis, redis.EventPublishTopic, data); err != nil {
 
 		log.Printf("❗️ Failed to publish event from block %d : %s\n", blockNumber, err.Error())
 		return false
@@ -0,0 +1,27 @@
+package block
+
+import (
+	"log"
+
+	d "github.com/itzmeanjan/ette/app/data"
+)
+
+// PublishPendingTransaction - Publishing pending tx or its follow up i.e. mined/
+// replaced/ dropped, on broker topic, to be captured by subscribers & sent
+// to client application, who are interested in this piece of data after applying filter
+func PublishPendingTransaction(tx *d.PendingTransaction, redis *d.RedisInfo) bool {
+
+	if tx == nil {
+		return false
+	}
+
+	if err := publish(redis, redis.PendingPublishTopic, tx); err != nil {
+
+		log.Printf("❗️ Failed to publish %s tx %s : %s\n", tx.Status, tx.Hash, err.Error())
+		return false
+
+	}
+
+	return true
+
+}
@@ -0,0 +1,27 @@
+package block
+
+import (
+	"log"
+
+	d "github.com/itzmeanjan/ette/app/data"
+)
+
+// PublishReorg - Attempts to publish chain reorganization info on broker topic,
+// so that subscribers can discard data they received for orphaned blocks
+func PublishReorg(reorg *d.Reorg, redis *d.RedisInfo) bool {
+
+	if reorg == nil {
+		return false
+	}
+
+	if err := publish(redis, redis.ReorgPublishTopic, reorg); err != nil {
+
+		log.Printf("❗️ Failed to publish reorg at %d : %s\n", reorg.Ancestor, err.Error())
+		return false
+
+	}
+
+	log.Printf("📎 Published reorg at %d, of depth %d\n", reorg.Ancestor, reorg.Depth)
+	return true
+
+}
@@ -0,0 +1,57 @@
+package block
+
+import (
+	"log"
+
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// PublishTokenTransfers - Iterate over all token transfers decoded from
+// event logs of tx & try to publish them on broker topic
+func PublishTokenTransfers(blockNumber uint64, transfers []*db.TokenTransfers, redis *d.RedisInfo) bool {
+
+	for _, t := range transfers {
+
+		if !PublishTokenTransfer(blockNumber, t, redis) {
+			return false
+		}
+
+	}
+
+	return true
+
+}
+
+// PublishTokenTransfer - Publishing token transfer on broker topic, to be captured
+// by subscribers & sent to client application, who are interested in this piece of data
+// after applying filter
+func PublishTokenTransfer(blockNumber uint64, transfer *db.TokenTransfers, redis *d.RedisInfo) bool {
+
+	if transfer == nil {
+		return false
+	}
+
+	data := &d.TokenTransfer{
+		Token:           transfer.Token,
+		Standard:        transfer.Standard,
+		From:            transfer.From,
+		To:              transfer.To,
+		TokenID:         transfer.TokenID,
+		Amount:          transfer.Amount,
+		Index:           transfer.Index,
+		BatchIndex:      transfer.BatchIndex,
+		TransactionHash: transfer.TransactionHash,
+		BlockHash:       transfer.BlockHash,
+	}
+
+	if err := publish(redis, redis.TransferPublishTopic, data); err != nil {
+
+		log.Printf("❗️ Failed to publish token transfer from block %d : %s\n", blockNumber, err.Error())
+		return false
+
+	}
+
+	return true
+
+}
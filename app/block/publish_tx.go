@@ -1,15 +1,14 @@
 package block
 
 import (
-	"context"
 	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 )
 
-// PublishTxs - Publishes all transactions in a block to redis pubsub
-// channel
+// PublishTxs - Publishes all transactions in a block on broker
+// topic
 func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisInfo) bool {
 
 	if txs == nil {
@@ -43,7 +42,7 @@ func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisI
 }
 
 // PublishTx - Publishes tx & events in tx, related data to respective
-// Redis pubsub channel
+// broker topics
 func PublishTx(blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo) bool {
 
 	if tx == nil {
@@ -84,13 +83,32 @@ func PublishTx(blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo)
 		}
 	}
 
-	if err := redis.Client.Publish(context.Background(), redis.TxPublishTopic, pTx).Err(); err != nil {
+	pTx.Type = tx.Tx.Type
+	pTx.MaxFeePerGas = tx.Tx.MaxFeePerGas
+	pTx.MaxPriorityFeePerGas = tx.Tx.MaxPriorityFeePerGas
+	pTx.EffectiveGasPrice = tx.Tx.EffectiveGasPrice
+	pTx.GasUsed = tx.Tx.GasUsed
+	pTx.AccessList = tx.Tx.AccessList
+	pTx.BlobGas = tx.Tx.BlobGas
+	pTx.MaxFeePerBlobGas = tx.Tx.MaxFeePerBlobGas
+	pTx.BlobGasPrice = tx.Tx.BlobGasPrice
+	pTx.BlobHashes = tx.Tx.BlobHashes
+
+	if err := publish(redis, redis.TxPublishTopic, pTx); err != nil {
 
 		log.Printf("❗️ Failed to publish transaction from block %d : %s\n", blockNumber, err.Error())
 		return false
 
 	}
 
-	return PublishEvents(blockNumber, tx.Events, redis)
+	// Pending tx(s) being tracked, which are now mined or replaced
+	// by this one, are to be followed up
+	NotifyMinedTransaction(blockNumber, tx.Tx, redis)
+
+	if !PublishEvents(blockNumber, tx.Events, redis) {
+		return false
+	}
+
+	return PublishTokenTransfers(blockNumber, tx.TokenTransfers, redis)
 
 }
@@ -0,0 +1,228 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+// unsupported - Keeps track of JSON-RPC methods, which some blockchain node
+// has rejected, so that they're not attempted again, against that node
+var unsupported sync.Map
+
+// isSupported - Checks whether method was ever rejected by this node
+func isSupported(raw *rpc.Client, method string) bool {
+	_, ok := unsupported.Load(fmt.Sprintf("%p/%s", raw, method))
+	return !ok
+}
+
+// markUnsupported - Remembers this node doesn't support given method, only
+// if node itself said so i.e. not due to some transient network failure
+// or rate limiting
+func markUnsupported(raw *rpc.Client, method string, err error) {
+
+	var rpcErr rpc.Error
+	var httpErr rpc.HTTPError
+
+	switch {
+
+	// Invalid request/ method not found
+	case errors.As(err, &rpcErr) && (rpcErr.ErrorCode() == -32600 || rpcErr.ErrorCode() == -32601):
+	// Any client side error, other than being rate limited
+	case errors.As(err, &httpErr) && httpErr.StatusCode >= 400 && httpErr.StatusCode < 500 && httpErr.StatusCode != 429:
+	default:
+		return
+
+	}
+
+	log.Printf("[!] Blockchain node doesn't support `%s` : %s\n", method, err.Error())
+	unsupported.Store(fmt.Sprintf("%p/%s", raw, method), true)
+
+}
+
+// validateReceipts - Checks whether receipts received are exactly
+// of those tx(s), present in this block, in same order
+func validateReceipts(block *types.Block, receipts []*types.Receipt) error {
+
+	if len(receipts) != block.Transactions().Len() {
+		return fmt.Errorf("expected %d receipts, received %d", block.Transactions().Len(), len(receipts))
+	}
+
+	for k, v := range block.Transactions() {
+
+		if receipts[k] == nil {
+			return fmt.Errorf("receipt of tx %s not found", v.Hash().Hex())
+		}
+
+		if receipts[k].TxHash != v.Hash() || receipts[k].BlockHash != block.Hash() {
+			return fmt.Errorf("receipt of tx %s doesn't belong to block %d", v.Hash().Hex(), block.NumberU64())
+		}
+
+	}
+
+	return nil
+
+}
+
+// fetchBlockReceipts - Fetching all receipts of block, in single call,
+// using `eth_getBlockReceipts`
+func fetchBlockReceipts(raw *rpc.Client, block *types.Block) ([]*types.Receipt, error) {
+
+	var receipts []*types.Receipt
+
+	start := time.Now()
+
+	err := raw.CallContext(context.Background(), &receipts, "eth_getBlockReceipts", block.Hash())
+	metrics.ObserveRPC("eth_getBlockReceipts", start, err)
+	if err != nil {
+
+		markUnsupported(raw, "eth_getBlockReceipts", err)
+		return nil, err
+
+	}
+
+	return receipts, nil
+
+}
+
+// batchFetchReceipts - Fetching all receipts of block, using JSON-RPC batch
+// requests, where each batch can have at max `BatchSize` requests
+func batchFetchReceipts(raw *rpc.Client, block *types.Block) ([]*types.Receipt, error) {
+
+	size := int(cfg.GetBatchSize())
+	receipts := make([]*types.Receipt, block.Transactions().Len())
+
+	for i := 0; i < len(receipts); i += size {
+
+		till := i + size
+		if till > len(receipts) {
+			till = len(receipts)
+		}
+
+		batch := make([]rpc.BatchElem, 0, till-i)
+
+		for j := i; j < till; j++ {
+
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []interface{}{block.Transactions()[j].Hash()},
+				Result: &receipts[j],
+			})
+
+		}
+
+		start := time.Now()
+
+		err := raw.BatchCallContext(context.Background(), batch)
+		metrics.ObserveRPC("batch/eth_getTransactionReceipt", start, err)
+		if err != nil {
+
+			markUnsupported(raw, "batch", err)
+			return nil, err
+
+		}
+
+		for _, v := range batch {
+
+			if v.Error != nil {
+				return nil, v.Error
+			}
+
+		}
+
+	}
+
+	return receipts, nil
+
+}
+
+// FetchReceipts - Fetching all tx receipts of block, in as few round trips as
+// possible, first attempting `eth_getBlockReceipts`, then batch requests
+//
+// If node supports none of them, error is returned, so that caller can
+// fall back to fetching receipts one by one
+func FetchReceipts(client *ethclient.Client, block *types.Block) ([]*types.Receipt, error) {
+
+	raw := client.Client()
+
+	if isSupported(raw, "eth_getBlockReceipts") {
+
+		receipts, err := fetchBlockReceipts(raw, block)
+		if err == nil {
+
+			if err = validateReceipts(block, receipts); err == nil {
+				return receipts, nil
+			}
+
+		}
+
+		log.Printf("❗️ Failed to fetch receipts of block %d, in one go : %s\n", block.NumberU64(), err.Error())
+
+	}
+
+	if cfg.GetBatchSize() == 0 || !isSupported(raw, "batch") {
+		return nil, errors.New("batching not supported")
+	}
+
+	receipts, err := batchFetchReceipts(raw, block)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateReceipts(block, receipts); err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+
+}
+
+// GetSender - Derives sender of tx locally, using signer of its chain, if
+// fails, asks blockchain node
+func GetSender(client *ethclient.Client, block *types.Block, tx *types.Transaction, index uint) (common.Address, error) {
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err == nil {
+		return sender, nil
+	}
+
+	start := time.Now()
+
+	sender, err = client.TransactionSender(context.Background(), tx, block.Hash(), index)
+	metrics.ObserveRPC("eth_getTransactionByBlockHashAndIndex", start, err)
+
+	return sender, err
+
+}
+
+// BuildPackedTxs - Given all receipts of block, builds tx data to be
+// published & persisted, while deriving senders locally
+func BuildPackedTxs(client *ethclient.Client, block *types.Block, receipts []*types.Receipt) ([]*db.PackedTransaction, error) {
+
+	packedTxs := make([]*db.PackedTransaction, block.Transactions().Len())
+
+	for k, v := range block.Transactions() {
+
+		sender, err := GetSender(client, block, v, receipts[k].TransactionIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		packedTxs[k] = BuildPackedTx(v, sender, receipts[k])
+
+	}
+
+	return packedTxs, nil
+
+}
@@ -0,0 +1,117 @@
+package block
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/gammazero/workerpool"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"gorm.io/gorm"
+)
+
+// ReindexAttempts - How many times block to be attempted to be
+// re-indexed, before giving up on it
+const ReindexAttempts = 3
+
+// ReindexBlock - Fetches block afresh & compares it with what's persisted,
+// replacing all mismatches found, attempting it few times, using
+// best blockchain node available at that moment
+func ReindexBlock(connection *d.BlockChainNodeConnection, _db *gorm.DB, number uint64, status *d.StatusHolder) *d.ReindexReport {
+
+	var reason string
+
+	for i := 0; i < ReindexAttempts; i++ {
+
+		client := connection.RPC.Get()
+		if client == nil {
+			reason = "no healthy blockchain node found"
+			continue
+		}
+
+		packedBlock, ok := FetchPackedBlockByNumber(client, number)
+		if !ok {
+			reason = "failed to fetch block"
+			continue
+		}
+
+		traced := cfg.IsCallTracingEnabled() && isSupported(client.Client(), "debug_traceBlockByHash")
+
+		report, err := db.ReindexBlock(_db, packedBlock, traced, status)
+		if err != nil {
+			reason = fmt.Sprintf("failed to persist block : %s", err.Error())
+			continue
+		}
+
+		return report
+
+	}
+
+	log.Printf("❗️ Failed to re-index block %d : %s\n", number, reason)
+
+	return &d.ReindexReport{
+		Number: number,
+		Status: d.ReindexFailed,
+		Error:  reason,
+	}
+
+}
+
+// Reindex - Re-indexes all blocks in [from, to] range concurrently, while
+// running n workers, where n = number of cores this machine has
+//
+// Waits for all of them to complete & returns what was changed
+func Reindex(connection *d.BlockChainNodeConnection, _db *gorm.DB, from uint64, to uint64, status *d.StatusHolder) *d.ReindexSummary {
+
+	summary := &d.ReindexSummary{From: from, To: to, Blocks: make([]*d.ReindexReport, 0)}
+
+	if !(from <= to) {
+		return summary
+	}
+
+	log.Printf("✅ Starting re-index of blocks [ %d - %d ]\n", from, to)
+
+	var lock sync.Mutex
+
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+
+	for i := from; i <= to; i++ {
+
+		func(num uint64) {
+			wp.Submit(func() {
+
+				report := ReindexBlock(connection, _db, num, status)
+				if report.Status != d.ReindexUnchanged && report.Status != d.ReindexFailed {
+					log.Printf("🔁 Re-indexed block %d [ Status : %s ]\n", num, report.Status)
+				}
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				summary.Add(report)
+
+			})
+		}(i)
+
+		// Avoiding overflow, when `to` is max possible block number
+		if i == to {
+			break
+		}
+
+	}
+
+	wp.StopWait()
+
+	sort.Slice(summary.Blocks, func(i, j int) bool {
+		return summary.Blocks[i].Number < summary.Blocks[j].Number
+	})
+
+	log.Printf("✅ Stopping re-index of blocks [ %d - %d ]\n", from, to)
+
+	return summary
+
+}
@@ -0,0 +1,196 @@
+package block
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
+)
+
+// FindCommonAncestor - Given newly received block header, walks back
+// through its ancestors ( only as far as required ), comparing them with
+// what's persisted in database, until both agree on some block
+//
+// Returns common ancestor's number & canonical block hashes above it, in
+// ascending order, along with whether any persisted block was found to be
+// orphaned or not. If it can't be decided, last return value is set to false
+func FindCommonAncestor(client *ethclient.Client, _db *gorm.DB, header *types.Header) (uint64, []string, bool, bool) {
+
+	persisted := func(number uint64) *db.Blocks {
+		return db.GetBlock(_db, number)
+	}
+
+	parent := func(number uint64, hash common.Hash) (common.Hash, error) {
+
+		start := time.Now()
+
+		_header, err := client.HeaderByHash(context.Background(), hash)
+		metrics.ObserveRPC("eth_getBlockByHash", start, err)
+		if err != nil {
+
+			log.Printf("❗️ Failed to fetch block header %d : %s\n", number, err.Error())
+			return common.Hash{}, err
+
+		}
+
+		return _header.ParentHash, nil
+
+	}
+
+	return findCommonAncestor(header, persisted, parent)
+
+}
+
+// findCommonAncestor - Walks back from given block header, looking up persisted
+// block at each height & asking for parent hash of canonical ones, until both
+// agree on some block, see `FindCommonAncestor`
+func findCommonAncestor(header *types.Header, persisted func(uint64) *db.Blocks, parent func(uint64, common.Hash) (common.Hash, error)) (uint64, []string, bool, bool) {
+
+	head := header.Number.Uint64()
+
+	number := head
+	hash := header.Hash()
+
+	// Canonical block hashes, seen while walking back, from head to ancestor
+	canonical := make([]string, 0, 2)
+	orphaned := false
+
+	for {
+
+		block := persisted(number)
+		if block != nil && block.Hash == hash.Hex() {
+			break
+		}
+
+		// Newly received block itself not being present in DB is
+		// pretty common, but if some block below it is missing, we can't
+		// compare it, so it's better to leave it for missing block finder
+		if block == nil && number != head && !orphaned {
+			return 0, nil, false, true
+		}
+
+		if block != nil {
+			orphaned = true
+		}
+
+		canonical = append(canonical, hash.Hex())
+
+		if number == 0 {
+
+			log.Printf("❗️ Failed to find common ancestor for block %d, reached genesis\n", head)
+			return 0, nil, false, false
+
+		}
+
+		if uint64(len(canonical)) > cfg.GetMaxReorgDepth() {
+
+			log.Printf("❗️ Failed to find common ancestor for block %d, within depth %d\n", head, cfg.GetMaxReorgDepth())
+			return 0, nil, false, false
+
+		}
+
+		// For newly received block, we already know its parent, so
+		// no need to ask node for it
+		if number == head {
+
+			hash = header.ParentHash
+
+		} else {
+
+			_hash, err := parent(number, hash)
+			if err != nil {
+				return 0, nil, false, false
+			}
+
+			hash = _hash
+
+		}
+
+		number--
+
+	}
+
+	// Reversing, so that canonical hashes are in ascending order of block number
+	for i, j := 0, len(canonical)-1; i < j; i, j = i+1, j-1 {
+		canonical[i], canonical[j] = canonical[j], canonical[i]
+	}
+
+	return number, canonical, orphaned, true
+
+}
+
+// HandleReorg - Checks whether newly received block header is building on top
+// of what's persisted in database, if not, all blocks above common ancestor are
+// rolled back & canonical ones are put into processing queue again
+//
+// Returns true if reorg was detected & handled, so that caller doesn't need to
+// process this block separately, it'll be picked up by retry queue manager
+func HandleReorg(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder, header *types.Header) bool {
+
+	ancestor, canonical, orphaned, ok := FindCommonAncestor(client, _db, header)
+	if !ok || !orphaned {
+		return false
+	}
+
+	orphans := db.GetBlocksAboveNumber(_db, ancestor)
+	if len(orphans) == 0 {
+		return false
+	}
+
+	oldHashes := make([]string, 0, len(orphans))
+	for _, v := range orphans {
+		oldHashes = append(oldHashes, v.Hash)
+	}
+
+	reorg := &db.Reorgs{
+		Ancestor:  ancestor,
+		Depth:     uint64(len(orphans)),
+		OldHead:   orphans[len(orphans)-1].Hash,
+		NewHead:   header.Hash().Hex(),
+		OldHashes: oldHashes,
+		NewHashes: canonical,
+	}
+
+	log.Printf("🔁 Reorg detected at %d, of depth %d [ Old Head : %s | New Head : %s ]\n", reorg.Ancestor, reorg.Depth, reorg.OldHead, reorg.NewHead)
+
+	if err := db.RollbackToAncestor(_db, reorg); err != nil {
+
+		log.Printf("❗️ Failed to rollback to block %d : %s\n", ancestor, err.Error())
+		return false
+
+	}
+
+	status.AddBlocksRemoved(reorg.Depth)
+
+	// Canonical blocks to be processed again, from scratch
+	for i := ancestor + 1; i <= header.Number.Uint64(); i++ {
+		queue.Requeue(i)
+	}
+
+	// Letting real-time subscribers know, data they received for
+	// orphaned blocks is no more valid
+	if cfg.IsRealtime() {
+
+		PublishReorg(&d.Reorg{
+			Ancestor:  reorg.Ancestor,
+			Depth:     reorg.Depth,
+			OldHead:   reorg.OldHead,
+			NewHead:   reorg.NewHead,
+			Orphaned:  oldHashes,
+			Canonical: canonical,
+		}, redis)
+
+	}
+
+	return true
+
+}
@@ -0,0 +1,164 @@
+package block
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// chain - Builds chain of block headers, from genesis, where `fork` is put
+// in extra data, so that chains with different forks have different hashes,
+// while sharing given headers as prefix
+func chain(prefix []*types.Header, length int, fork byte) []*types.Header {
+
+	headers := append(make([]*types.Header, 0, length), prefix...)
+
+	for i := len(prefix); i < length; i++ {
+
+		header := &types.Header{Number: big.NewInt(int64(i)), Extra: []byte{fork}, Difficulty: common.Big0}
+		if i != 0 {
+			header.ParentHash = headers[i-1].Hash()
+		}
+
+		headers = append(headers, header)
+
+	}
+
+	return headers
+
+}
+
+func TestFindCommonAncestor(t *testing.T) {
+
+	canonical := chain(nil, 6, 0)
+	forked := chain(canonical[:3], 5, 1)
+	unrelated := chain(nil, 6, 2)
+
+	// Every header known to node, looked up by its hash
+	known := make(map[common.Hash]*types.Header)
+	for _, headers := range [][]*types.Header{canonical, forked, unrelated} {
+		for _, v := range headers {
+			known[v.Hash()] = v
+		}
+	}
+
+	hashes := func(headers ...*types.Header) []string {
+
+		_hashes := make([]string, 0, len(headers))
+		for _, v := range headers {
+			_hashes = append(_hashes, v.Hash().Hex())
+		}
+
+		return _hashes
+
+	}
+
+	tests := []struct {
+		name      string
+		persisted []*types.Header
+		depth     string
+		failing   bool
+		ancestor  uint64
+		canonical []string
+		orphaned  bool
+		ok        bool
+	}{
+		{
+			name:      "building on persisted head",
+			persisted: canonical[:5],
+			ancestor:  4,
+			canonical: hashes(canonical[5]),
+			ok:        true,
+		},
+		{
+			name:      "already persisted",
+			persisted: canonical,
+			ancestor:  5,
+			canonical: hashes(),
+			ok:        true,
+		},
+		{
+			name:      "reorg",
+			persisted: forked,
+			ancestor:  2,
+			canonical: hashes(canonical[3:]...),
+			orphaned:  true,
+			ok:        true,
+		},
+		{
+			name:      "missing block below head",
+			persisted: canonical[:3],
+			ok:        true,
+		},
+		{
+			name:      "reorg deeper than allowed",
+			persisted: forked,
+			depth:     "2",
+		},
+		{
+			name:      "failing to fetch parent",
+			persisted: forked,
+			failing:   true,
+		},
+		{
+			name:      "reaching genesis",
+			persisted: unrelated,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			cfg.Set("MaxReorgDepth", tt.depth)
+
+			blocks := make(map[uint64]*db.Blocks)
+			for _, v := range tt.persisted {
+				blocks[v.Number.Uint64()] = &db.Blocks{Number: v.Number.Uint64(), Hash: v.Hash().Hex()}
+			}
+
+			persisted := func(number uint64) *db.Blocks {
+				return blocks[number]
+			}
+
+			parent := func(number uint64, hash common.Hash) (common.Hash, error) {
+
+				header, ok := known[hash]
+				if tt.failing || !ok {
+					return common.Hash{}, errors.New("not found")
+				}
+
+				return header.ParentHash, nil
+
+			}
+
+			ancestor, _canonical, orphaned, ok := findCommonAncestor(canonical[5], persisted, parent)
+
+			if ok != tt.ok || orphaned != tt.orphaned || ancestor != tt.ancestor {
+				t.Fatalf("expected ( %d, %t, %t ), got ( %d, %t, %t )", tt.ancestor, tt.orphaned, tt.ok, ancestor, orphaned, ok)
+			}
+
+			if len(_canonical) != len(tt.canonical) {
+				t.Fatalf("expected %d canonical block(s), got %d", len(tt.canonical), len(_canonical))
+			}
+
+			for k, v := range _canonical {
+
+				if v != tt.canonical[k] {
+					t.Errorf("expected canonical block %s at %d, got %s", tt.canonical[k], k, v)
+				}
+
+			}
+
+		})
+
+	}
+
+	cfg.Set("MaxReorgDepth", "")
+
+}
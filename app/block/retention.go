@@ -0,0 +1,67 @@
+package block
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+// FirstBlock - Lowest block number to be indexed, as per `StartBlock` &
+// `HistoryWindow`, unless retention service has decided to retain even
+// fewer blocks
+func FirstBlock(status *d.StatusHolder) uint64 {
+
+	first := cfg.GetFirstBlock(status.GetLatestBlockNumber())
+	if retained := status.GetRetainedFrom(); retained > first {
+		first = retained
+	}
+
+	return first
+
+}
+
+// FirstBlockSince - Finds out lowest block number in [0, latest] range, mined
+// at or after given unix timestamp, by binary searching block headers, when
+// none of them are, returns `latest + 1`
+func FirstBlockSince(connection *d.BlockChainNodeConnection, ts uint64, latest uint64) (uint64, bool) {
+
+	client := connection.RPC.Get()
+	if client == nil {
+		return 0, false
+	}
+
+	lo, hi := uint64(0), latest+1
+
+	for lo < hi {
+
+		mid := lo + (hi-lo)/2
+
+		start := time.Now()
+
+		header, err := client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(mid))
+		metrics.ObserveRPC("eth_getBlockByNumber", start, err)
+		if err != nil {
+
+			log.Printf("❗️ Failed to fetch block header %d : %s\n", mid, err.Error())
+			connection.RPC.Failed(client)
+			return 0, false
+
+		}
+
+		if header.Time >= ts {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+
+	}
+
+	connection.RPC.Succeeded(client)
+	return lo, true
+
+}
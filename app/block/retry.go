@@ -5,7 +5,6 @@ import (
 	"runtime"
 	"time"
 
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
@@ -19,7 +18,7 @@ import (
 // Sleeps for 1000 milliseconds
 //
 // Keeps repeating
-func RetryQueueManager(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+func RetryQueueManager(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 	sleep := func() {
 		time.Sleep(time.Duration(512) * time.Millisecond)
 	}
@@ -48,13 +47,18 @@ func RetryQueueManager(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 
 			wp.Submit(func() {
 
+				// Picking best endpoint, as of now, for processing this block
+				client := connection.RPC.Get()
+
 				if !FetchBlockByNumber(client, _blockNumber, _db, redis, true, queue, status) {
 
+					connection.RPC.Failed(client)
 					queue.UnconfirmedFailed(_blockNumber)
 					return
 
 				}
 
+				connection.RPC.Succeeded(client)
 				queue.UnconfirmedDone(_blockNumber)
 
 			})
@@ -6,7 +6,6 @@ import (
 	"sort"
 	"time"
 
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
 	"github.com/gookit/color"
 	cfg "github.com/itzmeanjan/ette/app/config"
@@ -45,7 +44,7 @@ func FindMissingBlocksInRange(found []uint64, from uint64, to uint64) []uint64 {
 // while running n workers concurrently, where n = number of cores this machine has
 //
 // Waits for all of them to complete
-func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder, jd func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue)) {
+func Syncer(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder, jd func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue)) {
 	if !(fromBlock <= toBlock) {
 		log.Print(color.Red.Sprintf("[!] Bad block range for syncer"))
 		return
@@ -57,7 +56,7 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 	// just mentioning which block needs to be fetched
 	job := func(num uint64) {
 		jd(wp, &d.Job{
-			Client: client,
+			Client: connection.RPC.Get(),
 			DB:     _db,
 			Redis:  redis,
 			Block:  num,
@@ -108,16 +107,16 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 	wp.StopWait()
 }
 
-// SyncBlocksByRange - Fetch & persist all blocks in range(fromBlock, toBlock), both inclusive
+// rangeSyncJob - Job to be submitted and executed by each worker, when
+// syncing blocks by range
 //
-// Range can be either ascending or descending, depending upon that proper arguments to be
-// passed to `Syncer` function during invokation
-func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
+// If bulk writer is given, blocks far from head are handed over to it,
+// instead of being persisted one by one
+//
+// Job specification is provided in `Job` struct
+func rangeSyncJob(connection *d.BlockChainNodeConnection, writer *BulkWriter) func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue) {
 
-	// Job to be submitted and executed by each worker
-	//
-	// Job specification is provided in `Job` struct
-	job := func(wp *workerpool.WorkerPool, j *d.Job, queue *q.BlockProcessorQueue) {
+	return func(wp *workerpool.WorkerPool, j *d.Job, queue *q.BlockProcessorQueue) {
 
 		wp.Submit(func() {
 
@@ -125,22 +124,84 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 				return
 			}
 
+			if writer != nil && writer.Eligible(j.Block) {
+
+				packedBlock, ok := FetchPackedBlockByNumber(j.Client, j.Block)
+				if !ok {
+					connection.RPC.Failed(j.Client)
+					queue.UnconfirmedFailed(j.Block)
+					return
+				}
+
+				connection.RPC.Succeeded(j.Client)
+
+				// Block gets marked as done/ failed, only after
+				// it's known whether it got written or not
+				num := j.Block
+				writer.Put(packedBlock, func(ok bool) {
+
+					if !ok {
+						queue.UnconfirmedFailed(num)
+						return
+					}
+
+					queue.UnconfirmedDone(num)
+
+				})
+				return
+
+			}
+
 			if !FetchBlockByNumber(j.Client, j.Block, j.DB, j.Redis, false, queue, j.Status) {
+				connection.RPC.Failed(j.Client)
 				queue.UnconfirmedFailed(j.Block)
 				return
 			}
 
+			connection.RPC.Succeeded(j.Client)
 			queue.UnconfirmedDone(j.Block)
 
 		})
+
+	}
+
+}
+
+// SyncBlocksByRange - Fetch & persist all blocks in range(fromBlock, toBlock), both inclusive
+//
+// Range can be either ascending or descending, depending upon that proper arguments to be
+// passed to `Syncer` function during invokation
+//
+// Blocks before first one to be indexed, as per `StartBlock`, `HistoryWindow` & retention
+// policies, are skipped
+func SyncBlocksByRange(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
+
+	// Initial sync can be huge, so blocks far from head are written in
+	// bulk, when asked for
+	var writer *BulkWriter
+	if cfg.IsBulkSyncEnabled() && cfg.IsHistorical() {
+		writer = NewBulkWriter(_db, status, queue)
 	}
 
+	job := rangeSyncJob(connection, writer)
+
 	log.Printf("✅ Starting block syncer\n")
 
-	if fromBlock < toBlock {
-		Syncer(client, _db, redis, queue, fromBlock, toBlock, status, job)
-	} else {
-		Syncer(client, _db, redis, queue, toBlock, fromBlock, status, job)
+	if fromBlock > toBlock {
+		fromBlock, toBlock = toBlock, fromBlock
+	}
+
+	// Blocks before first one to be indexed, are not to be synced
+	if first := FirstBlock(status); fromBlock < first {
+		fromBlock = first
+	}
+
+	if fromBlock <= toBlock {
+		Syncer(connection, _db, redis, queue, fromBlock, toBlock, status, job)
+	}
+
+	if writer != nil {
+		writer.Close()
 	}
 
 	log.Printf("✅ Stopping block syncer\n")
@@ -151,13 +212,13 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 	//
 	// And this will itself run as a infinite job, completes one iteration &
 	// takes break for 1 min, then repeats
-	go SyncMissingBlocksInDB(client, _db, redis, queue, status)
+	go SyncMissingBlocksInDB(connection, _db, redis, queue, status)
 
 }
 
 // SyncMissingBlocksInDB - Checks with database for what blocks are present & what are not, fetches missing
 // blocks & related data iteratively
-func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+func SyncMissingBlocksInDB(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 
 	for {
 
@@ -165,12 +226,16 @@ func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.Redi
 
 		currentBlockNumber := db.GetCurrentBlockNumber(_db)
 
-		// Safely reading shared variable
-		blockCount := status.BlockCountInDB()
+		// Blocks before this one are not to be looked for, as per
+		// `StartBlock`, `HistoryWindow` & retention policies
+		first := FirstBlock(status)
 
-		// If all blocks present in between 0 to latest block in network
-		// `ette` sleeps for 1 minute & again get to work
-		if currentBlockNumber+1 == blockCount {
+		// If all blocks present in between first block to be indexed & latest
+		// block in network, `ette` sleeps for 1 minute & again get to work
+		//
+		// Only blocks in that range are counted, because ones before first
+		// block may still be present, until they're pruned
+		if currentBlockNumber < first || currentBlockNumber+1-first == db.GetBlockCountInRange(_db, first, currentBlockNumber) {
 			log.Printf("✅ No missing blocks found\n")
 
 			<-time.After(time.Duration(1) * time.Minute)
@@ -195,17 +260,19 @@ func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.Redi
 				}
 
 				if !FetchBlockByNumber(j.Client, j.Block, j.DB, j.Redis, false, queue, j.Status) {
+					connection.RPC.Failed(j.Client)
 					queue.UnconfirmedFailed(j.Block)
 					return
 				}
 
+				connection.RPC.Succeeded(j.Client)
 				queue.UnconfirmedDone(j.Block)
 
 			})
 
 		}
 
-		Syncer(client, _db, redis, queue, 0, currentBlockNumber, status, job)
+		Syncer(connection, _db, redis, queue, first, currentBlockNumber, status, job)
 
 		log.Printf("✅ Stopping missing block finder\n")
 		<-time.After(time.Duration(1) * time.Minute)
@@ -0,0 +1,178 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+// callFrame - Single call in call tree of tx, as returned by `callTracer`
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Value   *hexutil.Big    `json:"value"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Error   string          `json:"error"`
+	Calls   []*callFrame    `json:"calls"`
+}
+
+// txTrace - Call tree of single tx, where older nodes don't return tx hash
+type txTrace struct {
+	TxHash *common.Hash `json:"txHash"`
+	Result *callFrame   `json:"result"`
+	Error  string       `json:"error"`
+}
+
+// traceBlock - Traces all tx(s) of block using `callTracer`, returning
+// call tree of each of them, keyed by tx hash
+//
+// Block is looked up by its hash, so that traces of some other block, which
+// replaced it in between, due to chain reorganization, can't be received
+func traceBlock(client *ethclient.Client, block *types.Block) (map[string]*callFrame, error) {
+
+	raw := client.Client()
+
+	var traces []*txTrace
+
+	start := time.Now()
+
+	err := raw.CallContext(context.Background(), &traces, "debug_traceBlockByHash", block.Hash(), map[string]interface{}{"tracer": "callTracer"})
+	metrics.ObserveRPC("debug_traceBlockByHash", start, err)
+	if err != nil {
+
+		markUnsupported(raw, "debug_traceBlockByHash", err)
+		return nil, err
+
+	}
+
+	if len(traces) != block.Transactions().Len() {
+		return nil, fmt.Errorf("expected %d traces, received %d", block.Transactions().Len(), len(traces))
+	}
+
+	frames := make(map[string]*callFrame, len(traces))
+
+	for k, v := range block.Transactions() {
+
+		if traces[k] == nil || traces[k].Result == nil {
+			return nil, fmt.Errorf("trace of tx %s not found", v.Hash().Hex())
+		}
+
+		if traces[k].Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %s : %s", v.Hash().Hex(), traces[k].Error)
+		}
+
+		if traces[k].TxHash != nil && *traces[k].TxHash != v.Hash() {
+			return nil, fmt.Errorf("trace of tx %s doesn't belong to block %d", v.Hash().Hex(), block.NumberU64())
+		}
+
+		frames[v.Hash().Hex()] = traces[k].Result
+
+	}
+
+	return frames, nil
+
+}
+
+// buildInternalTransactions - Flattens call tree of tx in depth first order,
+// skipping top level call, because that's tx itself
+func buildInternalTransactions(tx *db.Transactions, root *callFrame) []*db.InternalTransactions {
+
+	internalTxs := make([]*db.InternalTransactions, 0)
+
+	if root == nil {
+		return internalTxs
+	}
+
+	var flatten func(frame *callFrame, depth uint)
+	flatten = func(frame *callFrame, depth uint) {
+
+		for _, v := range frame.Calls {
+
+			if v == nil {
+				continue
+			}
+
+			internalTx := &db.InternalTransactions{
+				TransactionHash: tx.Hash,
+				Index:           uint(len(internalTxs)),
+				Depth:           depth,
+				Type:            strings.ToUpper(v.Type),
+				From:            v.From.Hex(),
+				Value:           "0",
+				Gas:             uint64(v.Gas),
+				GasUsed:         uint64(v.GasUsed),
+				Error:           v.Error,
+				BlockHash:       tx.BlockHash,
+			}
+
+			if v.Value != nil {
+				internalTx.Value = v.Value.ToInt().String()
+			}
+
+			if v.To != nil {
+
+				// Created contract is reported as callee
+				if internalTx.Type == "CREATE" || internalTx.Type == "CREATE2" {
+					internalTx.Contract = v.To.Hex()
+				} else {
+					internalTx.To = v.To.Hex()
+				}
+
+			}
+
+			internalTxs = append(internalTxs, internalTx)
+			flatten(v, depth+1)
+
+		}
+
+	}
+
+	flatten(root, 1)
+	return internalTxs
+
+}
+
+// AttachInternalTransactions - Traces all tx(s) of block & attaches internal tx(s)
+// to respective packed tx(s), to be persisted along with them
+//
+// If blockchain node doesn't expose `debug` namespace, it's skipped, so that
+// block processing doesn't get stuck
+func AttachInternalTransactions(client *ethclient.Client, block *types.Block, packedTxs []*db.PackedTransaction) bool {
+
+	if len(packedTxs) == 0 || !isSupported(client.Client(), "debug_traceBlockByHash") {
+		return true
+	}
+
+	frames, err := traceBlock(client, block)
+	if err != nil {
+
+		// Node said it doesn't support tracing, nothing more to do
+		if !isSupported(client.Client(), "debug_traceBlockByHash") {
+			return true
+		}
+
+		log.Printf("❗️ Failed to trace block %d : %s\n", block.NumberU64(), err.Error())
+		return false
+
+	}
+
+	// Packed tx(s) may not be in same order as they're in block, when
+	// fetched one by one, so they're matched by hash
+	for _, v := range packedTxs {
+		v.InternalTransactions = buildInternalTransactions(v.Tx, frames[v.Tx.Hash])
+	}
+
+	return true
+
+}
@@ -0,0 +1,67 @@
+package broker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	cfg "github.com/itzmeanjan/ette/app/config"
+)
+
+var (
+	// ErrTimeout - Nothing was received on subscription, while waiting
+	ErrTimeout = errors.New("nothing received")
+
+	// ErrClosed - Subscription has been closed, nothing to be received anymore
+	ErrClosed = errors.New("subscription closed")
+
+	// ErrBadID - ID, after which subscription was asked to be resumed, is not
+	// what broker assigns to published data
+	ErrBadID = errors.New("bad ID")
+)
+
+// Broker - Real-time data published by `ette`, on topics i.e. {block, transaction,
+// event, reorg, transfer, pending}, is fanned out to subscribers through it
+type Broker interface {
+	Publish(topic string, data []byte) error
+	Subscribe(topic string) (Subscription, error)
+	// SubscribeAfter - Subscribes to topic, starting right after data
+	// identified by given ID, as long as broker still keeps it, so
+	// that subscriber can resume from where it left off
+	SubscribeAfter(topic string, id string) (Subscription, error)
+	Close() error
+}
+
+// Subscription - Receives data published on topic, in order, starting from
+// when subscription was created
+type Subscription interface {
+	// Next - Waits for next piece of data, at max for given duration,
+	// returning `ErrTimeout` if nothing was received & `ErrClosed` once
+	// subscription is closed
+	Next(timeout time.Duration) (string, error)
+	// LastID - ID of last piece of data returned by `Next`, which can be
+	// used for resuming subscription later, empty if broker doesn't
+	// assign any
+	LastID() string
+	Close() error
+}
+
+// New - Creates broker, as set in config, where Redis client is used
+// only when Redis is chosen as broker
+func New(client *redis.Client) (Broker, error) {
+
+	switch cfg.GetBroker() {
+
+	case "redis":
+		return NewRedis(client, cfg.GetStreamLength()), nil
+	case "memory":
+		return NewMemory(cfg.GetStreamLength()), nil
+	case "nats":
+		return NewNATS(cfg.GetNatsURL())
+	default:
+		return nil, fmt.Errorf("unknown broker `%s`", cfg.GetBroker())
+
+	}
+
+}
@@ -0,0 +1,213 @@
+package broker
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Memory - Broker living inside `ette` process, keeping bounded log of latest
+// data published on each topic, so that single node `ette` doesn't need any
+// external broker, while slow subscribers never block publishing
+type Memory struct {
+	MaxLen int64
+	Topics map[string]*memoryLog
+	Lock   *sync.Mutex
+}
+
+// NewMemory - Creates in-process broker
+func NewMemory(maxLen int64) *Memory {
+	return &Memory{
+		MaxLen: maxLen,
+		Topics: make(map[string]*memoryLog),
+		Lock:   &sync.Mutex{},
+	}
+}
+
+// topic - Returns log of topic, creating it, if not yet
+func (m *Memory) topic(name string) *memoryLog {
+
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+
+	l, ok := m.Topics[name]
+	if !ok {
+
+		l = &memoryLog{
+			Entries: make([]string, 0),
+			Notify:  make(chan struct{}),
+			Lock:    &sync.RWMutex{},
+		}
+		m.Topics[name] = l
+
+	}
+
+	return l
+
+}
+
+// Publish - Appends data to log of topic, dropping oldest entries,
+// when it grows beyond limit
+func (m *Memory) Publish(topic string, data []byte) error {
+
+	l := m.topic(topic)
+
+	l.Lock.Lock()
+	defer l.Lock.Unlock()
+
+	l.Entries = append(l.Entries, string(data))
+
+	// Entries are dropped in chunks, so that they're not copied
+	// every time something gets published
+	if limit := int(m.MaxLen); len(l.Entries) > limit+limit/10 {
+
+		dropped := len(l.Entries) - limit
+
+		l.Entries = append(make([]string, 0, limit), l.Entries[dropped:]...)
+		l.First += uint64(dropped)
+
+	}
+
+	close(l.Notify)
+	l.Notify = make(chan struct{})
+
+	return nil
+
+}
+
+// Subscribe - Subscribes to log of topic, from its current end
+func (m *Memory) Subscribe(topic string) (Subscription, error) {
+
+	l := m.topic(topic)
+
+	l.Lock.RLock()
+	defer l.Lock.RUnlock()
+
+	return &memorySubscription{
+		Log:    l,
+		Cursor: l.First + uint64(len(l.Entries)),
+		Done:   make(chan struct{}),
+	}, nil
+
+}
+
+// SubscribeAfter - Subscribes to log of topic, starting right after entry
+// with given sequence number, never beyond current end
+func (m *Memory) SubscribeAfter(topic string, id string) (Subscription, error) {
+
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrBadID
+	}
+
+	l := m.topic(topic)
+
+	l.Lock.RLock()
+	defer l.Lock.RUnlock()
+
+	cursor := seq + 1
+	if end := l.First + uint64(len(l.Entries)); cursor > end {
+		cursor = end
+	}
+
+	return &memorySubscription{
+		Log:    l,
+		Cursor: cursor,
+		Done:   make(chan struct{}),
+	}, nil
+
+}
+
+// Close - Nothing to be done, because all data lives in memory
+func (m *Memory) Close() error {
+	return nil
+}
+
+// memoryLog - Latest data published on topic, where `First` is sequence
+// number of first entry kept
+type memoryLog struct {
+	Entries []string
+	First   uint64
+	Notify  chan struct{}
+	Lock    *sync.RWMutex
+}
+
+// memorySubscription - Reads entries of log, starting from sequence number
+// kept as cursor
+type memorySubscription struct {
+	Log    *memoryLog
+	Cursor uint64
+	Done   chan struct{}
+	Once   sync.Once
+}
+
+// Next - Returns next entry of log, waiting for it to be published, when
+// there's nothing new
+//
+// If subscriber has fallen so far behind that entries it has not yet received
+// are dropped, it continues from oldest one kept
+func (m *memorySubscription) Next(timeout time.Duration) (string, error) {
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+
+		select {
+		case <-m.Done:
+			return "", ErrClosed
+		default:
+		}
+
+		m.Log.Lock.RLock()
+
+		if m.Cursor < m.Log.First {
+			m.Cursor = m.Log.First
+		}
+
+		if index := m.Cursor - m.Log.First; index < uint64(len(m.Log.Entries)) {
+
+			msg := m.Log.Entries[index]
+			m.Log.Lock.RUnlock()
+
+			m.Cursor++
+			return msg, nil
+
+		}
+
+		wait := m.Log.Notify
+		m.Log.Lock.RUnlock()
+
+		select {
+		case <-m.Done:
+			return "", ErrClosed
+		case <-timer.C:
+			return "", ErrTimeout
+		case <-wait:
+		}
+
+	}
+
+}
+
+// LastID - Sequence number of last entry returned
+func (m *memorySubscription) LastID() string {
+
+	if m.Cursor == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(m.Cursor-1, 10)
+
+}
+
+// Close - Stops receiving from log
+func (m *memorySubscription) Close() error {
+
+	m.Once.Do(func() {
+		close(m.Done)
+	})
+
+	return nil
+
+}
@@ -0,0 +1,120 @@
+package broker
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMemorySubscribeAfter(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		maxLen    int64
+		published int
+		id        string
+		received  []string
+		err       error
+	}{
+		{
+			name:      "from start",
+			maxLen:    100,
+			published: 3,
+			id:        "0",
+			received:  []string{"1", "2"},
+		},
+		{
+			name:      "from middle",
+			maxLen:    100,
+			published: 5,
+			id:        "2",
+			received:  []string{"3", "4"},
+		},
+		{
+			name:      "from end",
+			maxLen:    100,
+			published: 3,
+			id:        "2",
+			received:  []string{},
+		},
+		{
+			name:      "beyond end",
+			maxLen:    100,
+			published: 3,
+			id:        "100",
+			received:  []string{},
+		},
+		{
+			name:      "after dropped entries",
+			maxLen:    2,
+			published: 5,
+			id:        "0",
+			received:  []string{"3", "4"},
+		},
+		{
+			name:      "bad id",
+			maxLen:    100,
+			published: 3,
+			id:        "1-0",
+			err:       ErrBadID,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			m := NewMemory(tt.maxLen)
+
+			for i := 0; i < tt.published; i++ {
+				if err := m.Publish("block", []byte(fmt.Sprintf("%d", i))); err != nil {
+					t.Fatalf("failed to publish : %s", err.Error())
+				}
+			}
+
+			sub, err := m.SubscribeAfter("block", tt.id)
+			if err != tt.err {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			defer sub.Close()
+
+			for _, want := range tt.received {
+
+				msg, err := sub.Next(time.Second)
+				if err != nil {
+					t.Fatalf("expected %s, got error %s", want, err.Error())
+				}
+
+				if msg != want {
+					t.Fatalf("expected %s, received %s", want, msg)
+				}
+
+				if sub.LastID() != want {
+					t.Errorf("expected last id %s, got %s", want, sub.LastID())
+				}
+
+			}
+
+			if _, err := sub.Next(time.Millisecond * 10); err != ErrTimeout {
+				t.Errorf("expected nothing more to be received, got %v", err)
+			}
+
+			// Resumed subscription must keep receiving, what's published later, in order
+			if err := m.Publish("block", []byte("next")); err != nil {
+				t.Fatalf("failed to publish : %s", err.Error())
+			}
+
+			if msg, err := sub.Next(time.Second); err != nil || msg != "next" {
+				t.Errorf("expected next, received %s, %v", msg, err)
+			}
+
+		})
+
+	}
+
+}
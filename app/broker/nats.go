@@ -0,0 +1,117 @@
+package broker
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// subjectPrefix - Topics are published on subjects under it, so that NATS
+// server can be shared with other applications
+const subjectPrefix = "ette."
+
+// NATS - Broker backed by NATS server, where topics are published on subjects,
+// without persisting them, so only subscribers attached at that moment receive
+// data
+type NATS struct {
+	Conn *nats.Conn
+}
+
+// NewNATS - Connects to NATS server, which keeps reconnecting, when
+// connection drops
+func NewNATS(url string) (*NATS, error) {
+
+	conn, err := nats.Connect(url,
+		nats.Name("ette"),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				log.Printf("[!] Disconnected from NATS : %s\n", err.Error())
+			}
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			log.Printf("[*] Reconnected to NATS %s\n", c.ConnectedUrl())
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS : %w", err)
+	}
+
+	return &NATS{Conn: conn}, nil
+
+}
+
+// Publish - Publishes data on subject of topic
+func (n *NATS) Publish(topic string, data []byte) error {
+	return n.Conn.Publish(subjectPrefix+topic, data)
+}
+
+// Subscribe - Subscribes to subject of topic, where data received is kept
+// by NATS client, until read
+func (n *NATS) Subscribe(topic string) (Subscription, error) {
+
+	sub, err := n.Conn.SubscribeSync(subjectPrefix + topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsSubscription{Sub: sub}, nil
+
+}
+
+// SubscribeAfter - NATS doesn't keep published data, so subscription can't
+// be resumed, rather it starts from now on
+func (n *NATS) SubscribeAfter(topic string, _ string) (Subscription, error) {
+	return n.Subscribe(topic)
+}
+
+// Close - Closes connection to NATS, after sending whatever is yet to be sent
+func (n *NATS) Close() error {
+	return n.Conn.Drain()
+}
+
+// natsSubscription - Subscription to subject of topic
+type natsSubscription struct {
+	Sub *nats.Subscription
+}
+
+// Next - Returns next message received on subject
+func (n *natsSubscription) Next(timeout time.Duration) (string, error) {
+
+	msg, err := n.Sub.NextMsg(timeout)
+	switch err {
+
+	case nil:
+		return string(msg.Data), nil
+	case nats.ErrTimeout:
+		return "", ErrTimeout
+	case nats.ErrBadSubscription, nats.ErrConnectionClosed:
+		return "", ErrClosed
+	case nats.ErrSlowConsumer:
+
+		// Subscriber is too slow, so messages got dropped by
+		// client, but it can keep receiving
+		log.Printf("[!] Dropped messages received from `%s`, too slow\n", n.Sub.Subject)
+		return "", ErrTimeout
+
+	default:
+
+		log.Printf("[!] Failed to receive from `%s` : %s\n", n.Sub.Subject, err.Error())
+
+		<-time.After(timeout)
+		return "", ErrTimeout
+
+	}
+
+}
+
+// LastID - Nothing is assigned ID by NATS
+func (n *natsSubscription) LastID() string {
+	return ""
+}
+
+// Close - Unsubscribes from subject
+func (n *natsSubscription) Close() error {
+	return n.Sub.Unsubscribe()
+}
@@ -0,0 +1,319 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// field - Field of Redis stream entry, holding published data
+const field = "data"
+
+// keyPrefix - Streams are kept under keys having it, so that same Redis can be
+// shared with other applications
+const keyPrefix = "ette:"
+
+// entryID - Format of ID, Redis assigns to stream entry i.e. `<ms>-<seq>`
+var entryID = regexp.MustCompile(`^[0-9]+-[0-9]+$`)
+
+// Redis - Broker backed by Redis streams, one per topic, each trimmed to
+// approximately configured number of latest entries, so that data stays
+// there even when no subscriber is attached, while memory usage stays bounded
+type Redis struct {
+	Client  *redis.Client
+	MaxLen  int64
+	Streams map[string]*stream
+	Lock    *sync.Mutex
+}
+
+// NewRedis - Creates broker backed by Redis streams
+func NewRedis(client *redis.Client, maxLen int64) *Redis {
+	return &Redis{
+		Client:  client,
+		MaxLen:  maxLen,
+		Streams: make(map[string]*stream),
+		Lock:    &sync.Mutex{},
+	}
+}
+
+// Publish - Appends data to stream of topic
+func (r *Redis) Publish(topic string, data []byte) error {
+
+	return r.Client.XAdd(context.Background(), &redis.XAddArgs{
+		Stream:       keyPrefix + topic,
+		MaxLenApprox: r.MaxLen,
+		Values:       map[string]interface{}{field: data},
+	}).Err()
+
+}
+
+// Subscribe - Subscribes to stream of topic, from its current tail
+func (r *Redis) Subscribe(topic string) (Subscription, error) {
+
+	s := r.stream(topic)
+	return s.subscribe(s.tail()), nil
+
+}
+
+// SubscribeAfter - Subscribes to stream of topic, starting right after entry
+// with given ID, or oldest one kept, if that's already trimmed
+func (r *Redis) SubscribeAfter(topic string, id string) (Subscription, error) {
+
+	if !entryID.MatchString(id) {
+		return nil, ErrBadID
+	}
+
+	return r.stream(topic).subscribe(id), nil
+
+}
+
+// stream - Returns stream of topic, which starts being watched, when
+// subscribed to for first time
+func (r *Redis) stream(topic string) *stream {
+
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	s, ok := r.Streams[topic]
+	if !ok {
+
+		s = &stream{
+			Client: r.Client,
+			Key:    keyPrefix + topic,
+			Notify: make(chan struct{}),
+			Lock:   &sync.RWMutex{},
+		}
+
+		go s.watch(s.tail())
+		r.Streams[topic] = s
+
+	}
+
+	return s
+
+}
+
+// Close - Nothing to be done, because Redis client is owned by caller
+func (r *Redis) Close() error {
+	return nil
+}
+
+// stream - Redis stream of topic, which is watched by single go routine, waking up
+// all subscriptions of this topic, in this process, when new entries are appended
+//
+// Subscriptions read from stream on their own, each from where it left off, but
+// without blocking, so none of them keep one connection to Redis busy
+type stream struct {
+	Client *redis.Client
+	Key    string
+	Notify chan struct{}
+	Lock   *sync.RWMutex
+}
+
+// subscribe - Creates subscription to stream, reading entries appended
+// after given one
+func (s *stream) subscribe(after string) *redisSubscription {
+	return &redisSubscription{
+		Stream:  s,
+		Cursor:  after,
+		Last:    after,
+		Pending: make([]redis.XMessage, 0),
+		Done:    make(chan struct{}),
+	}
+}
+
+// tail - ID of last entry in stream, after which reading is to be started,
+// for receiving only those entries, which are appended from now on
+//
+// If stream can't be looked up, current time is used as ID, because entry
+// IDs are time of appending, in milliseconds
+func (s *stream) tail() string {
+
+	entries, err := s.Client.XRevRangeN(context.Background(), s.Key, "+", "-", 1).Result()
+	if err != nil {
+
+		log.Printf("[!] Failed to find tail of `%s` stream : %s\n", s.Key, err.Error())
+		return fmt.Sprintf("%d-0", time.Now().UnixNano()/int64(time.Millisecond))
+
+	}
+
+	if len(entries) == 0 {
+		return "0-0"
+	}
+
+	return entries[0].ID
+
+}
+
+// watch - Keeps blocking on stream, for new entries, waking up all
+// subscriptions when they're appended
+func (s *stream) watch(last string) {
+
+	for {
+
+		res, err := s.Client.XRead(context.Background(), &redis.XReadArgs{
+			Streams: []string{s.Key, last},
+			Count:   100,
+			Block:   time.Second,
+		}).Result()
+		if err != nil {
+
+			// Nothing got appended in a second
+			if err == redis.Nil {
+				continue
+			}
+
+			log.Printf("[!] Failed to watch `%s` stream : %s\n", s.Key, err.Error())
+			<-time.After(time.Second)
+			continue
+
+		}
+
+		for _, v := range res {
+
+			if n := len(v.Messages); n > 0 {
+				last = v.Messages[n-1].ID
+			}
+
+		}
+
+		s.Lock.Lock()
+
+		close(s.Notify)
+		s.Notify = make(chan struct{})
+
+		s.Lock.Unlock()
+
+	}
+
+}
+
+// wait - Returns channel, which gets closed when new entries are appended
+func (s *stream) wait() <-chan struct{} {
+
+	s.Lock.RLock()
+	defer s.Lock.RUnlock()
+
+	return s.Notify
+
+}
+
+// read - Reads entries appended after given one, without blocking, returning
+// those holding data, along with ID of last one read
+func (s *stream) read(after string) ([]redis.XMessage, string) {
+
+	res, err := s.Client.XRead(context.Background(), &redis.XReadArgs{
+		Streams: []string{s.Key, after},
+		Count:   100,
+		Block:   -1,
+	}).Result()
+	if err != nil {
+
+		if err != redis.Nil {
+			log.Printf("[!] Failed to read from `%s` stream : %s\n", s.Key, err.Error())
+		}
+
+		return nil, after
+
+	}
+
+	msgs := make([]redis.XMessage, 0)
+
+	for _, v := range res {
+
+		for _, m := range v.Messages {
+
+			after = m.ID
+
+			if _, ok := m.Values[field].(string); ok {
+				msgs = append(msgs, m)
+			}
+
+		}
+
+	}
+
+	return msgs, after
+
+}
+
+// redisSubscription - Reads entries appended to stream after cursor, keeping
+// those read but not yet received, in order, along with ID of last one received
+type redisSubscription struct {
+	Stream  *stream
+	Cursor  string
+	Last    string
+	Pending []redis.XMessage
+	Done    chan struct{}
+	Once    sync.Once
+}
+
+// Next - Returns next piece of data appended to stream, waiting to be woken
+// up, when there's nothing new
+func (r *redisSubscription) Next(timeout time.Duration) (string, error) {
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+
+		select {
+		case <-r.Done:
+			return "", ErrClosed
+		default:
+		}
+
+		if len(r.Pending) != 0 {
+
+			msg := r.Pending[0]
+			r.Pending = r.Pending[1:]
+
+			r.Last = msg.ID
+			return msg.Values[field].(string), nil
+
+		}
+
+		// Obtained before reading, so that entries appended while
+		// reading aren't missed
+		wait := r.Stream.wait()
+
+		msgs, last := r.Stream.read(r.Cursor)
+		r.Cursor = last
+
+		if len(msgs) != 0 {
+			r.Pending = msgs
+			continue
+		}
+
+		select {
+		case <-r.Done:
+			return "", ErrClosed
+		case <-timer.C:
+			return "", ErrTimeout
+		case <-wait:
+		}
+
+	}
+
+}
+
+// LastID - ID of last stream entry received
+func (r *redisSubscription) LastID() string {
+	return r.Last
+}
+
+// Close - Stops receiving from stream
+func (r *redisSubscription) Close() error {
+
+	r.Once.Do(func() {
+		close(r.Done)
+	})
+
+	return nil
+
+}
@@ -0,0 +1,294 @@
+package app
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
+)
+
+// usage - Lists all commands `ette` understands
+func usage() {
+
+	fmt.Fprintf(os.Stderr, `Usage : ette <command> [flags]
+
+Commands :
+  serve                     Run historical and/ or real-time data service
+  snapshot take|restore     Take snapshot of database/ restore database from snapshot
+  backfill                  Fetch & persist blocks in range, missing in database
+  reindex                   Fetch blocks in range afresh & fix mismatches found in database
+  verify                    Check configuration, connectivity, blocks missing in database & their integrity
+  keys list|create|toggle   Manage API keys
+
+Run 'ette <command> -h' for flags of command.
+
+When no command is given, 'ette' runs as per 'EtteMode' set in config file.
+`)
+
+}
+
+// absPath - Finds absolute path of file, given from command line
+func absPath(file string) string {
+
+	_file, err := filepath.Abs(file)
+	if err != nil {
+		log.Fatalf("[!] Failed to find `%s` : %s\n", file, err.Error())
+	}
+
+	return _file
+
+}
+
+// exit - Exits with non-zero status code, if command failed
+func exit(ok bool) {
+
+	if !ok {
+		os.Exit(1)
+	}
+
+}
+
+// newFlagSet - Creates flag set for command, with flags for config
+// file paths, which are common to all commands
+func newFlagSet(name string) (*flag.FlagSet, *string, *string) {
+
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+
+	configFile := fs.String("config", ".env", "path to config file")
+	subscriptionPlansFile := fs.String("plans", ".plans.json", "path to subscription plans file")
+
+	return fs, configFile, subscriptionPlansFile
+
+}
+
+// isFlagSet - Checks whether flag was explicitly provided from command line
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+
+	found := false
+
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			found = true
+		}
+	})
+
+	return found
+
+}
+
+// Execute - Parses command line arguments & runs requested command
+func Execute(args []string) {
+
+	// Without any command, running as per `EtteMode`, while
+	// config file paths can still be provided
+	if len(args) == 0 || (strings.HasPrefix(args[0], "-") && args[0] != "-h" && args[0] != "--help") {
+
+		fs, configFile, subscriptionPlansFile := newFlagSet("ette")
+		fs.Parse(args)
+
+		Run(absPath(*configFile), absPath(*subscriptionPlansFile))
+		return
+
+	}
+
+	switch args[0] {
+
+	case "serve":
+		serveCommand(args[1:])
+	case "snapshot":
+		snapshotCommand(args[1:])
+	case "backfill":
+		backfillCommand(args[1:])
+	case "reindex":
+		reindexCommand(args[1:])
+	case "verify":
+		verifyCommand(args[1:])
+	case "keys":
+		keysCommand(args[1:])
+	case "help", "-h", "--help":
+		usage()
+	default:
+		usage()
+		os.Exit(2)
+
+	}
+
+}
+
+// serveCommand - `ette serve [--historical] [--realtime]`
+//
+// When neither of modes is asked for, `EtteMode` from config file is used
+func serveCommand(args []string) {
+
+	fs, configFile, subscriptionPlansFile := newFlagSet("serve")
+
+	historical := fs.Bool("historical", false, "persist block data & serve historical data queries")
+	realtime := fs.Bool("realtime", false, "publish block data & deliver real-time notifications")
+
+	fs.Parse(args)
+
+	readConfig(absPath(*configFile))
+
+	if isFlagSet(fs, "historical") || isFlagSet(fs, "realtime") {
+		cfg.SetMode(*historical, *realtime)
+	}
+
+	Serve(absPath(*subscriptionPlansFile))
+
+}
+
+// snapshotCommand - `ette snapshot take|restore [--file snapshot.bin]`
+func snapshotCommand(args []string) {
+
+	if len(args) == 0 || !(args[0] == "take" || args[0] == "restore") {
+
+		fmt.Fprintf(os.Stderr, "Usage : ette snapshot take|restore [flags]\n")
+		os.Exit(2)
+
+	}
+
+	fs, configFile, _ := newFlagSet(fmt.Sprintf("snapshot %s", args[0]))
+
+	file := fs.String("file", "", "path to snapshot file, defaults to SnapshotFile in config file")
+
+	fs.Parse(args[1:])
+
+	readConfig(absPath(*configFile))
+
+	if *file != "" {
+		cfg.Set("SnapshotFile", *file)
+	}
+
+	if args[0] == "take" {
+		exit(TakeSnapshot(cfg.GetSnapshotFile()))
+		return
+	}
+
+	exit(RestoreSnapshot(cfg.GetSnapshotFile()))
+
+}
+
+// backfillCommand - `ette backfill --from <block> [--to <block>]`
+func backfillCommand(args []string) {
+
+	fs, configFile, subscriptionPlansFile := newFlagSet("backfill")
+
+	from := fs.Uint64("from", 0, "first block of range to be backfilled")
+	to := fs.Uint64("to", 0, "last block of range to be backfilled, defaults to latest block having required confirmations")
+
+	fs.Parse(args)
+
+	if !isFlagSet(fs, "from") {
+
+		fmt.Fprintf(os.Stderr, "Usage : ette backfill --from <block> [--to <block>]\n")
+		os.Exit(2)
+
+	}
+
+	readConfig(absPath(*configFile))
+
+	exit(Backfill(absPath(*subscriptionPlansFile), *from, *to, isFlagSet(fs, "to")))
+
+}
+
+// reindexCommand - `ette reindex --from <block> [--to <block>] [--report <file>]`
+func reindexCommand(args []string) {
+
+	fs, configFile, _ := newFlagSet("reindex")
+
+	from := fs.Uint64("from", 0, "first block of range to be re-indexed")
+	to := fs.Uint64("to", 0, "last block of range to be re-indexed, defaults to latest block having required confirmations")
+	report := fs.String("report", "", "path to file, where report of what was changed to be written as JSON")
+
+	fs.Parse(args)
+
+	if !isFlagSet(fs, "from") {
+
+		fmt.Fprintf(os.Stderr, "Usage : ette reindex --from <block> [--to <block>] [--report <file>]\n")
+		os.Exit(2)
+
+	}
+
+	readConfig(absPath(*configFile))
+
+	reportFile := ""
+	if *report != "" {
+		reportFile = absPath(*report)
+	}
+
+	exit(Reindex(*from, *to, isFlagSet(fs, "to"), reportFile))
+
+}
+
+// verifyCommand - `ette verify [--integrity [--from <block>] [--to <block>] [--samples <count>]]`
+func verifyCommand(args []string) {
+
+	fs, configFile, _ := newFlagSet("verify")
+
+	integrity := fs.Bool("integrity", false, "verify integrity of persisted blocks too")
+	from := fs.Uint64("from", 0, "first block of range to be verified, defaults to oldest persisted block")
+	to := fs.Uint64("to", 0, "last block of range to be verified, defaults to latest persisted block")
+	samples := fs.Uint64("samples", 0, "how many blocks from every 1000 to be compared against blockchain node")
+
+	fs.Parse(args)
+
+	readConfig(absPath(*configFile))
+
+	exit(Verify(*integrity, *from, isFlagSet(fs, "from"), *to, isFlagSet(fs, "to"), *samples))
+
+}
+
+// keysCommand - `ette keys list|create --address <address>` & `ette keys toggle --key <apiKey>`
+func keysCommand(args []string) {
+
+	if len(args) == 0 || !(args[0] == "list" || args[0] == "create" || args[0] == "toggle") {
+
+		fmt.Fprintf(os.Stderr, "Usage : ette keys list|create|toggle [flags]\n")
+		os.Exit(2)
+
+	}
+
+	fs, configFile, subscriptionPlansFile := newFlagSet(fmt.Sprintf("keys %s", args[0]))
+
+	address := fs.String("address", "", "account address, API keys belong to")
+	apiKey := fs.String("key", "", "API key, to be toggled")
+
+	fs.Parse(args[1:])
+
+	if args[0] == "toggle" {
+
+		if *apiKey == "" {
+
+			fmt.Fprintf(os.Stderr, "Usage : ette keys toggle --key <apiKey>\n")
+			os.Exit(2)
+
+		}
+
+		readConfig(absPath(*configFile))
+		exit(ToggleKey(*apiKey))
+		return
+
+	}
+
+	if !common.IsHexAddress(*address) {
+
+		fmt.Fprintf(os.Stderr, "Usage : ette keys %s --address <address>\n", args[0])
+		os.Exit(2)
+
+	}
+
+	readConfig(absPath(*configFile))
+
+	if args[0] == "list" {
+		exit(ListKeys(common.HexToAddress(*address)))
+		return
+	}
+
+	exit(CreateKey(absPath(*subscriptionPlansFile), common.HexToAddress(*address)))
+
+}
@@ -6,27 +6,32 @@ import (
 
 	"github.com/go-redis/redis/v8"
 
-	"github.com/ethereum/go-ethereum/ethclient"
 	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
 )
 
-// Connect to blockchain node, either using HTTP or Websocket connection
+// Connect to blockchain nodes, either using HTTP or Websocket connection
 // depending upon true/ false, passed to function, respectively
-func getClient(isRPC bool) *ethclient.Client {
-	var client *ethclient.Client
-	var err error
+//
+// Multiple comma separated endpoints can be specified, which are kept in
+// pool & checked for health once, before returning
+func getClient(isRPC bool) *d.EndpointPool {
+	var urls []string
 
 	if isRPC {
-		client, err = ethclient.Dial(cfg.Get("RPCUrl"))
+		urls = cfg.GetRPCUrls()
 	} else {
-		client, err = ethclient.Dial(cfg.Get("WebsocketUrl"))
+		urls = cfg.GetWebsocketUrls()
 	}
 
+	pool, err := d.NewEndpointPool(urls)
 	if err != nil {
 		log.Fatalf("[!] Failed to connect to blockchain : %s\n", err.Error())
 	}
 
-	return client
+	pool.Check(context.Background())
+
+	return pool
 }
 
 // Creates connection to Redis server & returns that handle to be used for further communication
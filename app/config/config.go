@@ -4,6 +4,7 @@ import (
 	"log"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -20,6 +21,158 @@ func Get(key string) string {
 	return viper.GetString(key)
 }
 
+// Set - Overrides config value by key, to be used when same
+// is provided from command line
+func Set(key string, value string) {
+	viper.Set(key, value)
+}
+
+// IsHistorical - Whether `ette` is to persist block data in database, for
+// serving historical data queries, which is when `EtteMode` is 1 or 3
+func IsHistorical() bool {
+	return Get("EtteMode") == "1" || Get("EtteMode") == "3"
+}
+
+// IsRealtime - Whether `ette` is to publish block data on pubsub topics, for
+// delivering real-time notifications, which is when `EtteMode` is 2 or 3
+func IsRealtime() bool {
+	return Get("EtteMode") == "2" || Get("EtteMode") == "3"
+}
+
+// SetMode - Sets `EtteMode`, given what's asked for from command line, so
+// that rest of `ette` doesn't need to know where mode came from
+func SetMode(historical bool, realtime bool) {
+
+	switch {
+
+	case historical && realtime:
+		Set("EtteMode", "3")
+	case historical:
+		Set("EtteMode", "1")
+	case realtime:
+		Set("EtteMode", "2")
+
+	}
+
+}
+
+// GetList - Reads comma separated list, specified against given key in
+// `.env` file, returning entries after trimming whitespaces around each of them
+func GetList(key string) []string {
+
+	entries := make([]string, 0)
+
+	for _, v := range strings.Split(Get(key), ",") {
+
+		if _v := strings.TrimSpace(v); _v != "" {
+			entries = append(entries, _v)
+		}
+
+	}
+
+	return entries
+
+}
+
+// GetURLs - Reads comma separated list of URLs, specified against given key
+// in `.env` file, returning them after trimming whitespaces around each of them
+func GetURLs(key string) []string {
+	return GetList(key)
+}
+
+// GetFilterAddresses - Accounts, tx(s) sent from/ to or deploying, to be
+// persisted & published, along with all of their event logs
+func GetFilterAddresses() []string {
+	return GetList("FilterAddresses")
+}
+
+// GetFilterContracts - Contracts, event logs emitted by, to be persisted &
+// published, along with tx(s) emitting them
+func GetFilterContracts() []string {
+	return GetList("FilterContracts")
+}
+
+// GetFilterTopics - Event signatures i.e. topic0, event logs having, to be
+// persisted & published, along with tx(s) emitting them
+func GetFilterTopics() []string {
+	return GetList("FilterTopics")
+}
+
+// GetRPCUrls - HTTP based blockchain node endpoints, to be used
+// for querying blockchain data, in failover manner
+func GetRPCUrls() []string {
+	return GetURLs("RPCUrl")
+}
+
+// GetWebsocketUrls - Websocket based blockchain node endpoints, to be used
+// for listening to new block headers, in failover manner
+func GetWebsocketUrls() []string {
+	return GetURLs("WebsocketUrl")
+}
+
+// GetHealthCheckInterval - Time span between two consecutive health
+// checks of blockchain node endpoints, in terms of second
+func GetHealthCheckInterval() uint64 {
+
+	interval := Get("HealthCheckInterval")
+	if interval == "" {
+		return 10
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil || parsedInterval == 0 {
+		log.Printf("[!] Failed to parse health check interval\n")
+		return 10
+	}
+
+	return parsedInterval
+
+}
+
+// GetMaxEndpointLag - How many blocks one blockchain node endpoint can be
+// lagging behind best known head, while still being considered for selection
+func GetMaxEndpointLag() uint64 {
+
+	lag := Get("MaxEndpointLag")
+	if lag == "" {
+		return 2
+	}
+
+	parsedLag, err := strconv.ParseUint(lag, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse max endpoint lag : %s\n", err.Error())
+		return 2
+	}
+
+	return parsedLag
+
+}
+
+// GetReplicaURLs - Connection strings of read replicas of database, to
+// which read only API queries are to be routed
+func GetReplicaURLs() []string {
+	return GetList("DB_REPLICAS")
+}
+
+// GetMaxReplicaLag - How many blocks read replica can be lagging behind
+// primary, while still being used for serving API queries
+func GetMaxReplicaLag() uint64 {
+
+	lag := Get("MaxReplicaLag")
+	if lag == "" {
+		return 10
+	}
+
+	parsedLag, err := strconv.ParseUint(lag, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse max replica lag : %s\n", err.Error())
+		return 10
+	}
+
+	return parsedLag
+
+}
+
 // GetConcurrencyFactor - Reads concurrency factor specified in `.env` file, during deployment
 // and returns that number as unsigned integer
 func GetConcurrencyFactor() uint64 {
@@ -58,6 +211,307 @@ func GetBlockConfirmations() uint64 {
 
 }
 
+// GetStartBlock - Block number from where `ette` starts indexing, blocks
+// before it are neither synced nor looked for, if not provided, 0 is used
+// as default
+func GetStartBlock() uint64 {
+
+	start := Get("StartBlock")
+	if start == "" {
+		return 0
+	}
+
+	parsedStart, err := strconv.ParseUint(start, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse start block : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedStart
+
+}
+
+// GetHistoryWindow - Number of latest blocks `ette` keeps indexed, blocks
+// before them are neither synced nor looked for, if not provided, 0 is
+// used as default, denoting whole history
+func GetHistoryWindow() uint64 {
+
+	window := Get("HistoryWindow")
+	if window == "" {
+		return 0
+	}
+
+	parsedWindow, err := strconv.ParseUint(window, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse history window : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedWindow
+
+}
+
+// GetFirstBlock - Given latest block number, returns lowest block number
+// to be indexed, as per start block & history window, whichever is higher
+func GetFirstBlock(latest uint64) uint64 {
+
+	first := GetStartBlock()
+
+	if window := GetHistoryWindow(); window != 0 && latest+1 > window && latest+1-window > first {
+		first = latest + 1 - window
+	}
+
+	return first
+
+}
+
+// IsRetentionEnabled - Whether blocks & event logs, falling out of retention
+// policies, are to be pruned periodically
+func IsRetentionEnabled() bool {
+	return strings.ToLower(Get("Retention")) == "yes"
+}
+
+// GetRetentionDays - Blocks mined in how many latest days to be kept, if
+// not provided, 0 is used as default, denoting no limit
+func GetRetentionDays() uint64 {
+
+	days := Get("RetentionDays")
+	if days == "" {
+		return 0
+	}
+
+	parsedDays, err := strconv.ParseUint(days, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse retention days : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedDays
+
+}
+
+// GetRetentionContracts - Contracts, whose event logs are to be kept, while
+// rest of them are pruned, when nothing provided, all of them are kept
+func GetRetentionContracts() []string {
+	return GetList("RetentionContracts")
+}
+
+// GetRetentionInterval - Gap between two consecutive pruning runs, in
+// seconds, if not provided, 3600 is used as default
+func GetRetentionInterval() uint64 {
+
+	interval := Get("RetentionInterval")
+	if interval == "" {
+		return 3600
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil || parsedInterval == 0 {
+		log.Printf("[!] Failed to parse retention interval\n")
+		return 3600
+	}
+
+	return parsedInterval
+
+}
+
+// GetRetentionBatchSize - How many blocks/ delivery histories to be pruned
+// inside single database transaction, so that tables don't stay locked for
+// long, if not provided, 1000 is used as default
+func GetRetentionBatchSize() uint64 {
+
+	size := Get("RetentionBatchSize")
+	if size == "" {
+		return 1000
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil || parsedSize == 0 {
+		log.Printf("[!] Failed to parse retention batch size\n")
+		return 1000
+	}
+
+	return parsedSize
+
+}
+
+// GetMaxReorgDepth - Max depth of chain reorganization `ette` will attempt
+// to walk back through, while looking for common ancestor of persisted &
+// canonical chain, if not provided, 64 is used as default
+func GetMaxReorgDepth() uint64 {
+
+	depth := Get("MaxReorgDepth")
+	if depth == "" {
+		return 64
+	}
+
+	parsedDepth, err := strconv.ParseUint(depth, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse max reorg depth : %s\n", err.Error())
+		return 64
+	}
+
+	return parsedDepth
+
+}
+
+// GetBatchSize - Max number of requests to be sent in single JSON-RPC
+// batch, while fetching tx receipts of block, if not provided, 100 is used
+// as default
+//
+// Setting it to 0, disables batching
+func GetBatchSize() uint64 {
+
+	size := Get("BatchSize")
+	if size == "" {
+		return 100
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse batch size : %s\n", err.Error())
+		return 100
+	}
+
+	return parsedSize
+
+}
+
+// IsCallTracingEnabled - Whether calls made during tx execution are to be
+// traced using `debug_traceBlockByHash`, for indexing internal tx(s), which
+// requires blockchain node to expose `debug` namespace
+func IsCallTracingEnabled() bool {
+	return strings.ToLower(Get("TraceCalls")) == "yes"
+}
+
+// IsPendingTxStreamingEnabled - Whether pending tx(s) seen in mempool of
+// blockchain node are to be published on `pending` topic, which requires
+// websocket endpoint to support `newPendingTransactions` subscription
+func IsPendingTxStreamingEnabled() bool {
+	return strings.ToLower(Get("PendingTxs")) == "yes"
+}
+
+// IsIntegrityCheckEnabled - Whether persisted blocks are to be verified
+// continuously, for finding inconsistencies, while `ette` is running
+func IsIntegrityCheckEnabled() bool {
+	return strings.ToLower(Get("IntegrityCheck")) == "yes"
+}
+
+// GetIntegrityCheckInterval - Seconds to wait before verifying next chunk of
+// persisted blocks, if not provided, 10 is used as default
+func GetIntegrityCheckInterval() uint64 {
+
+	interval := Get("IntegrityCheckInterval")
+	if interval == "" {
+		return 10
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse integrity check interval : %s\n", err.Error())
+		return 10
+	}
+
+	return parsedInterval
+
+}
+
+// GetIntegrityCheckSamples - How many blocks from each verified chunk to be
+// compared against blockchain node, if not provided, 0 is used as default
+// i.e. nothing compared against blockchain node
+func GetIntegrityCheckSamples() uint64 {
+
+	samples := Get("IntegrityCheckSamples")
+	if samples == "" {
+		return 0
+	}
+
+	parsedSamples, err := strconv.ParseUint(samples, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse integrity check samples : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedSamples
+
+}
+
+// IsBulkSyncEnabled - Whether blocks far from head, fetched while syncing
+// block range, are to be written to database in bulk
+func IsBulkSyncEnabled() bool {
+	return strings.ToLower(Get("BulkSync")) == "yes"
+}
+
+// IsIndexDeferralEnabled - Whether secondary indexes are to be dropped before
+// writing blocks in bulk & created again once done
+func IsIndexDeferralEnabled() bool {
+	return strings.ToLower(Get("BulkSyncDeferIndexes")) == "yes"
+}
+
+// GetBulkSyncBatchSize - Max number of blocks to be written to database in
+// single go, while syncing in bulk, if not provided, 100 is used as default
+func GetBulkSyncBatchSize() uint64 {
+
+	size := Get("BulkSyncBatchSize")
+	if size == "" {
+		return 100
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil || parsedSize == 0 {
+		log.Printf("[!] Failed to parse bulk sync batch size\n")
+		return 100
+	}
+
+	return parsedSize
+
+}
+
+// GetBulkSyncDistance - How many blocks behind latest block, block needs to
+// be, for being written to database in bulk, if not provided, 1000 is used
+// as default
+func GetBulkSyncDistance() uint64 {
+
+	distance := Get("BulkSyncDistance")
+	if distance == "" {
+		return 1000
+	}
+
+	parsedDistance, err := strconv.ParseUint(distance, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse bulk sync distance : %s\n", err.Error())
+		return 1000
+	}
+
+	return parsedDistance
+
+}
+
+// IsPartitioningEnabled - Whether blocks, tx(s) & event logs are to be kept in
+// tables partitioned by block number range
+func IsPartitioningEnabled() bool {
+	return strings.ToLower(Get("Partitioning")) == "yes"
+}
+
+// GetPartitionSize - Returns how many blocks are to be kept in each partition,
+// which must not be changed once partitions are created
+func GetPartitionSize() uint64 {
+
+	size := Get("PartitionSize")
+	if size == "" {
+		return 1000000
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil || parsedSize == 0 {
+		log.Printf("[!] Failed to parse partition size, must be non-zero : %s\n", size)
+		return 1000000
+	}
+
+	return parsedSize
+
+}
+
 // GetBlockNumberRange - Returns how many blocks can be queried at a time
 // when performing range based queries from client side
 func GetBlockNumberRange() uint64 {
@@ -96,6 +550,111 @@ func GetTimeRange() uint64 {
 
 }
 
+// GetBroker - Message broker to be used for fanning out real-time data to
+// subscribers i.e. {redis, memory, nats}, if not provided, `redis` is used
+// as default
+func GetBroker() string {
+
+	broker := strings.ToLower(Get("Broker"))
+	if broker == "" {
+		return "redis"
+	}
+
+	return broker
+
+}
+
+// GetMetricsAddress - Address metrics are exposed on, for being scraped by
+// Prometheus, if not provided, `127.0.0.1:7001` is used as default
+func GetMetricsAddress() string {
+
+	address := Get("MetricsAddress")
+	if address == "" {
+		return "127.0.0.1:7001"
+	}
+
+	return address
+
+}
+
+// GetNatsURL - URL of NATS server, to be used when it's chosen as broker,
+// if not provided, `nats://127.0.0.1:4222` is used as default
+func GetNatsURL() string {
+
+	url := Get("NatsURL")
+	if url == "" {
+		return "nats://127.0.0.1:4222"
+	}
+
+	return url
+
+}
+
+// GetStreamLength - Approximate number of latest entries to be kept in Redis
+// stream of each topic, where published data is appended, if not provided,
+// 10000 is used as default
+func GetStreamLength() int64 {
+
+	length := Get("RedisStreamLength")
+	if length == "" {
+		return 10000
+	}
+
+	parsedLength, err := strconv.ParseInt(length, 10, 64)
+	if err != nil || parsedLength <= 0 {
+		log.Printf("[!] Failed to parse redis stream length, must be positive : %s\n", length)
+		return 10000
+	}
+
+	return parsedLength
+
+}
+
+// IsWebhookDeliveryEnabled - Whether real-time data is to be delivered to
+// registered webhooks, by this `ette` instance
+func IsWebhookDeliveryEnabled() bool {
+	return strings.ToLower(Get("WebhookDelivery")) == "yes"
+}
+
+// GetWebhookMaxAttempts - How many times delivery of data to webhook to be
+// attempted, before it's put in dead letter table, if not provided, 5 is used
+// as default
+func GetWebhookMaxAttempts() uint64 {
+
+	attempts := Get("WebhookMaxAttempts")
+	if attempts == "" {
+		return 5
+	}
+
+	parsedAttempts, err := strconv.ParseUint(attempts, 10, 64)
+	if err != nil || parsedAttempts == 0 {
+		log.Printf("[!] Failed to parse webhook max attempts, must be positive : %s\n", attempts)
+		return 5
+	}
+
+	return parsedAttempts
+
+}
+
+// GetWebhookTimeout - Seconds to wait for webhook to respond, before delivery
+// attempt is considered to be failed, if not provided, 10 is used as default
+func GetWebhookTimeout() uint64 {
+
+	timeout := Get("WebhookTimeout")
+	if timeout == "" {
+		return 10
+	}
+
+	parsedTimeout, err := strconv.ParseUint(timeout, 10, 64)
+	if err != nil || parsedTimeout == 0 {
+		log.Printf("[!] Failed to parse webhook timeout, must be positive : %s\n", timeout)
+		return 10
+	}
+
+	return parsedTimeout
+
+}
+
 // GetSnapshotFile - Reading snapshot file name from
 // config file, if not provided, `snapshot.bin` is used as default file name
 func GetSnapshotFile() string {
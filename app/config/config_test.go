@@ -0,0 +1,44 @@
+package config
+
+import "testing"
+
+func TestGetFirstBlock(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		start  string
+		window string
+		latest uint64
+		first  uint64
+	}{
+		{name: "nothing set", latest: 100, first: 0},
+		{name: "start block", start: "10", latest: 100, first: 10},
+		{name: "start block ahead of latest", start: "200", latest: 100, first: 200},
+		{name: "window", window: "10", latest: 100, first: 91},
+		{name: "window covering whole chain", window: "101", latest: 100, first: 0},
+		{name: "window longer than chain", window: "1000", latest: 100, first: 0},
+		{name: "window of one block", window: "1", latest: 100, first: 100},
+		{name: "window ahead of start block", start: "10", window: "10", latest: 100, first: 91},
+		{name: "start block ahead of window", start: "95", window: "10", latest: 100, first: 95},
+		{name: "bad window", start: "10", window: "ten", latest: 100, first: 10},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			Set("StartBlock", tt.start)
+			Set("HistoryWindow", tt.window)
+
+			if first := GetFirstBlock(tt.latest); first != tt.first {
+				t.Errorf("expected first block %d, got %d", tt.first, first)
+			}
+
+		})
+
+	}
+
+	Set("StartBlock", "")
+	Set("HistoryWindow", "")
+
+}
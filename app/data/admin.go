@@ -0,0 +1,17 @@
+package data
+
+// QueueBlockPayload - Payload to be sent in POST request body, when
+// admin wants to act on single block present in block processor queue
+//
+// Block number is kept as pointer, so that genesis block i.e. 0 isn't
+// rejected as missing
+type QueueBlockPayload struct {
+	Number *uint64 `json:"number" binding:"required"`
+}
+
+// QueueRangePayload - Payload to be sent in POST request body, when
+// admin wants to put range of blocks into block processor queue
+type QueueRangePayload struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
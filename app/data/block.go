@@ -24,6 +24,48 @@ type Block struct {
 	TransactionRootHash string  `json:"txRootHash" gorm:"column:txroothash"`
 	ReceiptRootHash     string  `json:"receiptRootHash" gorm:"column:receiptroothash"`
 	ExtraData           []byte  `json:"extraData" gorm:"column:extradata"`
+	BaseFee             string  `json:"baseFeePerGas" gorm:"column:basefee"`
+	BlobGasUsed         uint64  `json:"blobGasUsed" gorm:"column:blobgasused"`
+	ExcessBlobGas       uint64  `json:"excessBlobGas" gorm:"column:excessblobgas"`
+	Withdrawals         []byte  `json:"withdrawals" gorm:"column:withdrawals"`
+}
+
+// Withdrawal - Validator withdrawal, pushed from consensus layer
+// into execution layer block, where amount is in Gwei
+type Withdrawal struct {
+	Index     uint64 `json:"index"`
+	Validator uint64 `json:"validatorIndex"`
+	Address   string `json:"address"`
+	Amount    uint64 `json:"amount"`
+}
+
+// DecodeWithdrawals - Decodes JSON encoded withdrawals, as persisted in database
+func DecodeWithdrawals(data []byte) []*Withdrawal {
+
+	withdrawals := make([]*Withdrawal, 0)
+
+	if len(data) == 0 {
+		return withdrawals
+	}
+
+	if err := json.Unmarshal(data, &withdrawals); err != nil {
+		log.Printf("[!] Failed to decode withdrawals : %s\n", err.Error())
+	}
+
+	return withdrawals
+
+}
+
+// RawJSON - Returns JSON encoded data, as it's, if present, otherwise JSON null,
+// to be used when embedding JSON encoded field in custom JSON encoder
+func RawJSON(data []byte) string {
+
+	if len(data) == 0 {
+		return "null"
+	}
+
+	return string(data)
+
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -40,7 +82,7 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 		extraData = fmt.Sprintf("0x%s", _h)
 	}
 
-	return []byte(fmt.Sprintf(`{"hash":%q,"number":%d,"time":%d,"parentHash":%q,"difficulty":%q,"gasUsed":%d,"gasLimit":%d,"nonce":%q,"miner":%q,"size":%f,"stateRootHash":%q,"uncleHash":%q,"txRootHash":%q,"receiptRootHash":%q,"extraData":%q}`,
+	return []byte(fmt.Sprintf(`{"hash":%q,"number":%d,"time":%d,"parentHash":%q,"difficulty":%q,"gasUsed":%d,"gasLimit":%d,"nonce":%q,"miner":%q,"size":%f,"stateRootHash":%q,"uncleHash":%q,"txRootHash":%q,"receiptRootHash":%q,"extraData":%q,"baseFeePerGas":%q,"blobGasUsed":%d,"excessBlobGas":%d,"withdrawals":%s}`,
 		b.Hash,
 		b.Number,
 		b.Time,
@@ -55,7 +97,11 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 		b.UncleHash,
 		b.TransactionRootHash,
 		b.ReceiptRootHash,
-		extraData)), nil
+		extraData,
+		b.BaseFee,
+		b.BlobGasUsed,
+		b.ExcessBlobGas,
+		RawJSON(b.Withdrawals))), nil
 
 }
 
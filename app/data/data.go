@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-redis/redis/v8"
+	"github.com/itzmeanjan/ette/app/broker"
 	"gorm.io/gorm"
 )
 
@@ -16,12 +17,14 @@ type SyncState struct {
 	BlockCountAtStartUp     uint64
 	MaxBlockNumberAtStartUp uint64
 	NewBlocksInserted       uint64
+	BlocksRemoved           uint64
 	LatestBlockNumber       uint64
+	RetainedFrom            uint64
 }
 
 // BlockCountInDB - Blocks currently present in database
 func (s *SyncState) BlockCountInDB() uint64 {
-	return s.BlockCountAtStartUp + s.NewBlocksInserted
+	return s.BlockCountAtStartUp + s.NewBlocksInserted - s.BlocksRemoved
 }
 
 // StatusHolder - Keeps track of progress being made by `ette` over time,
@@ -64,6 +67,17 @@ func (s *StatusHolder) IncrementBlocksInserted() {
 
 }
 
+// AddBlocksRemoved - Increments number of blocks removed from DB
+// after `ette` started processing blocks, due to chain reorganization
+func (s *StatusHolder) AddBlocksRemoved(count uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.BlocksRemoved += count
+
+}
+
 // IncrementBlocksProcessed - Increments number of blocks processed by `ette
 // after it started
 func (s *StatusHolder) IncrementBlocksProcessed() {
@@ -85,6 +99,17 @@ func (s *StatusHolder) BlockCountInDB() uint64 {
 
 }
 
+// BlocksInserted - Safely reads #-of blocks inserted into DB, after
+// `ette` started processing blocks
+func (s *StatusHolder) BlocksInserted() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.NewBlocksInserted
+
+}
+
 // ElapsedTime - Uptime of `ette`
 func (s *StatusHolder) ElapsedTime() time.Duration {
 
@@ -125,11 +150,34 @@ func (s *StatusHolder) SetLatestBlockNumber(num uint64) {
 
 }
 
+// GetRetainedFrom - Safely reads lowest block number being retained, as
+// decided by retention service, during its last run
+func (s *StatusHolder) GetRetainedFrom() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.RetainedFrom
+
+}
+
+// SetRetainedFrom - Safely writes lowest block number being retained
+func (s *StatusHolder) SetRetainedFrom(num uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.RetainedFrom = num
+
+}
+
 // RedisInfo - Holds redis related information in this struct, to be used
-// when passing to functions as argument
+// when passing to functions as argument, along with broker, on whose topics
+// real-time data is published
 type RedisInfo struct {
-	Client                                               *redis.Client // using this object `ette` will talk to Redis
-	BlockPublishTopic, TxPublishTopic, EventPublishTopic string
+	Client                                                                                             *redis.Client // using this object `ette` will talk to Redis
+	Broker                                                                                             broker.Broker // using this object `ette` will publish real-time data
+	BlockPublishTopic, TxPublishTopic, EventPublishTopic, ReorgPublishTopic, TransferPublishTopic, PendingPublishTopic string
 }
 
 // ResultStatus - Keeps track of how many operations went successful
@@ -156,12 +204,3 @@ type Job struct {
 	Block  uint64
 	Status *StatusHolder
 }
-
-// BlockChainNodeConnection - Holds network connection object for blockchain nodes
-//
-// Use `RPC` i.e. HTTP based connection, for querying blockchain for data
-// Use `Websocket` for real-time listening of events in blockchain
-type BlockChainNodeConnection struct {
-	RPC       *ethclient.Client
-	Websocket *ethclient.Client
-}
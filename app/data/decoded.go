@@ -0,0 +1,74 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// DecodedArg - Single argument of decoded event/ method call, where value
+// is kept in JSON friendly form i.e. big integers as decimal strings,
+// byte arrays as hex strings
+type DecodedArg struct {
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	Indexed bool        `json:"indexed,omitempty"`
+	Value   interface{} `json:"value"`
+}
+
+// DecodedEvent - Event log decoded using ABI of contract, which emitted it
+type DecodedEvent struct {
+	Name      string        `json:"name"`
+	Signature string        `json:"signature"`
+	Args      []*DecodedArg `json:"args"`
+}
+
+// ToJSON - Encodes into JSON, to be embedded into event data
+func (d *DecodedEvent) ToJSON() []byte {
+	data, err := json.Marshal(d)
+	if err != nil {
+		log.Printf("[!] Failed to encode decoded event to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
+
+// DecodedCall - Tx input data decoded using ABI of contract, being invoked
+type DecodedCall struct {
+	Method    string        `json:"method"`
+	Signature string        `json:"signature"`
+	Inputs    []*DecodedArg `json:"inputs"`
+}
+
+// ToJSON - Encodes into JSON, to be embedded into tx data
+func (d *DecodedCall) ToJSON() []byte {
+	data, err := json.Marshal(d)
+	if err != nil {
+		log.Printf("[!] Failed to encode decoded call to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
+
+// ABIPayload - Payload to be sent in post request body, when uploading
+// ABI of contract, where ABI can be sent either as JSON array or as
+// JSON encoded string
+type ABIPayload struct {
+	Address string          `json:"address" binding:"required"`
+	ABI     json.RawMessage `json:"abi" binding:"required"`
+}
+
+// GetABI - Returns ABI as JSON encoded string, unwrapping it, if
+// it was sent as string
+func (a *ABIPayload) GetABI() string {
+
+	var abi string
+
+	if err := json.Unmarshal(a.ABI, &abi); err == nil {
+		return abi
+	}
+
+	return string(a.ABI)
+
+}
@@ -18,6 +18,7 @@ type Event struct {
 	Data            []byte         `gorm:"column:data"`
 	TransactionHash string         `gorm:"column:txhash"`
 	BlockHash       string         `gorm:"column:blockhash"`
+	Decoded         *DecodedEvent  `gorm:"-"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -34,13 +35,22 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 		data = fmt.Sprintf("0x%s", _h)
 	}
 
-	return []byte(fmt.Sprintf(`{"origin":%q,"index":%d,"topics":%v,"data":%q,"txHash":%q,"blockHash":%q}`,
+	// Decoded form is present only when asked for & ABI of
+	// emitter contract is known
+	decoded := ""
+	if e.Decoded != nil {
+		if _d := e.Decoded.ToJSON(); _d != nil {
+			decoded = fmt.Sprintf(`,"decoded":%s`, _d)
+		}
+	}
+
+	return []byte(fmt.Sprintf(`{"origin":%q,"index":%d,"topics":%v,"data":%q,"txHash":%q,"blockHash":%q%s}`,
 		e.Origin,
 		e.Index,
 		strings.Join(
 			strings.Fields(
 				fmt.Sprintf("%q", e.Topics)), ","),
-		data, e.TransactionHash, e.BlockHash)), nil
+		data, e.TransactionHash, e.BlockHash, decoded)), nil
 
 }
 
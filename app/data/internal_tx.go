@@ -0,0 +1,42 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// InternalTransaction - Call made by contract during tx execution, to be
+// delivered to client in this format
+//
+// For contract creations `to` is empty & `contract` holds created contract
+// address, while `error` is non-empty when call got reverted
+type InternalTransaction struct {
+	TransactionHash string `json:"txHash" gorm:"column:txhash"`
+	Index           uint   `json:"index" gorm:"column:index"`
+	Depth           uint   `json:"depth" gorm:"column:depth"`
+	Type            string `json:"type" gorm:"column:type"`
+	From            string `json:"from" gorm:"column:from"`
+	To              string `json:"to" gorm:"column:to"`
+	Contract        string `json:"contract" gorm:"column:contract"`
+	Value           string `json:"value" gorm:"column:value"`
+	Gas             uint64 `json:"gas" gorm:"column:gas"`
+	GasUsed         uint64 `json:"gasUsed" gorm:"column:gasused"`
+	Error           string `json:"error" gorm:"column:error"`
+	BlockHash       string `json:"blockHash" gorm:"column:blockhash"`
+}
+
+// InternalTransactions - A collection of internal tx(s), to be delivered to client in this form
+type InternalTransactions struct {
+	InternalTransactions []*InternalTransaction `json:"internalTransactions"`
+}
+
+// ToJSON - Encodes into JSON, to be delivered to client
+func (i *InternalTransactions) ToJSON() []byte {
+	data, err := json.Marshal(i)
+	if err != nil {
+		log.Printf("[!] Failed to encode internal tx(s) to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,357 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	cfg "github.com/itzmeanjan/ette/app/config"
+)
+
+// MaxConsecutiveErrors - After these many consecutive failures, endpoint
+// to be considered unhealthy, until next successful health check
+const MaxConsecutiveErrors = 3
+
+// closeDelay - For how long client replaced by redialing is kept open, so
+// that operations already being performed using it, can complete
+const closeDelay = time.Minute
+
+// Endpoint - Single blockchain node endpoint, along with
+// health related info, gathered over time
+type Endpoint struct {
+	URL               string
+	Client            *ethclient.Client
+	Healthy           bool
+	Head              uint64
+	Latency           time.Duration
+	Errors            uint64
+	ConsecutiveErrors uint64
+	LastChecked       time.Time
+}
+
+// IsWebsocket - Whether this endpoint talks over websocket or not
+func (e *Endpoint) IsWebsocket() bool {
+	return strings.HasPrefix(e.URL, "ws://") || strings.HasPrefix(e.URL, "wss://")
+}
+
+// EndpointPool - Pool of blockchain node endpoints, among which one best
+// endpoint gets picked up, every time some client asks for it
+//
+// Best endpoint is one which is healthy, not lagging behind best known head
+// by more than `MaxEndpointLag` blocks & has lowest latency
+//
+// It's concurrent safe
+type EndpointPool struct {
+	Endpoints []*Endpoint
+	Lock      *sync.RWMutex
+}
+
+// NewEndpointPool - Dials all given endpoints & returns pool of them,
+// failing only when none of them could be dialed
+func NewEndpointPool(urls []string) (*EndpointPool, error) {
+
+	pool := &EndpointPool{
+		Endpoints: make([]*Endpoint, 0, len(urls)),
+		Lock:      &sync.RWMutex{},
+	}
+
+	dialed := 0
+
+	for _, v := range urls {
+
+		endpoint := &Endpoint{URL: v}
+
+		client, err := ethclient.Dial(v)
+		if err != nil {
+			log.Printf("[!] Failed to connect to blockchain node `%s` : %s\n", v, err.Error())
+
+			endpoint.Errors++
+			endpoint.ConsecutiveErrors++
+		} else {
+			endpoint.Client = client
+			endpoint.Healthy = true
+
+			dialed++
+		}
+
+		pool.Endpoints = append(pool.Endpoints, endpoint)
+
+	}
+
+	if dialed == 0 {
+		return nil, errors.New("failed to connect to any blockchain node")
+	}
+
+	return pool, nil
+
+}
+
+// Get - Returns client for best endpoint, as of now
+//
+// If none of them are healthy, one with least consecutive failures
+// is returned, with hope it'll work
+func (e *EndpointPool) Get() *ethclient.Client {
+
+	e.Lock.RLock()
+	defer e.Lock.RUnlock()
+
+	var head uint64
+	for _, v := range e.Endpoints {
+
+		if v.Client != nil && v.Healthy && v.Head > head {
+			head = v.Head
+		}
+
+	}
+
+	var selected *Endpoint
+
+	for _, v := range e.Endpoints {
+
+		if v.Client == nil || !v.Healthy {
+			continue
+		}
+
+		if v.Head+cfg.GetMaxEndpointLag() < head {
+			continue
+		}
+
+		if selected == nil || v.Latency < selected.Latency {
+			selected = v
+		}
+
+	}
+
+	if selected != nil {
+		return selected.Client
+	}
+
+	for _, v := range e.Endpoints {
+
+		if v.Client == nil {
+			continue
+		}
+
+		if selected == nil || v.ConsecutiveErrors < selected.ConsecutiveErrors {
+			selected = v
+		}
+
+	}
+
+	if selected == nil {
+		return nil
+	}
+
+	return selected.Client
+
+}
+
+// find - Finds endpoint owning given client, lock to be held by caller
+func (e *EndpointPool) find(client *ethclient.Client) *Endpoint {
+
+	for _, v := range e.Endpoints {
+
+		if v.Client == client {
+			return v
+		}
+
+	}
+
+	return nil
+
+}
+
+// Failed - Marks operation performed using this client has failed, after
+// `MaxConsecutiveErrors` consecutive failures, endpoint is not considered
+// for selection, until it passes health check again
+func (e *EndpointPool) Failed(client *ethclient.Client) {
+
+	e.Lock.Lock()
+	defer e.Lock.Unlock()
+
+	endpoint := e.find(client)
+	if endpoint == nil {
+		return
+	}
+
+	endpoint.Errors++
+	endpoint.ConsecutiveErrors++
+
+	if endpoint.Healthy && endpoint.ConsecutiveErrors >= MaxConsecutiveErrors {
+
+		log.Printf("[!] Marking blockchain node `%s` unhealthy\n", endpoint.URL)
+		endpoint.Healthy = false
+
+	}
+
+}
+
+// Disconnected - Marks endpoint unhealthy right away, because its connection
+// is known to be broken, it'll be dialed again during next health check
+func (e *EndpointPool) Disconnected(client *ethclient.Client) {
+
+	e.Lock.Lock()
+	defer e.Lock.Unlock()
+
+	endpoint := e.find(client)
+	if endpoint == nil {
+		return
+	}
+
+	endpoint.Errors++
+	endpoint.ConsecutiveErrors++
+	endpoint.Healthy = false
+
+}
+
+// Succeeded - Marks operation performed using this client has succeeded,
+// resetting consecutive failure count
+func (e *EndpointPool) Succeeded(client *ethclient.Client) {
+
+	e.Lock.Lock()
+	defer e.Lock.Unlock()
+
+	endpoint := e.find(client)
+	if endpoint == nil {
+		return
+	}
+
+	endpoint.ConsecutiveErrors = 0
+
+}
+
+// check - Checks health of single endpoint, by asking for latest block number,
+// while measuring how long it took
+//
+// Websocket endpoints, which are unhealthy, are dialed again, because
+// underlying connection might have been broken, where new client is swapped
+// in first & old one is closed after `closeDelay`
+func (e *EndpointPool) check(ctx context.Context, endpoint *Endpoint) {
+
+	e.Lock.RLock()
+	client := endpoint.Client
+	redial := client == nil || (!endpoint.Healthy && endpoint.IsWebsocket())
+	e.Lock.RUnlock()
+
+	if redial {
+
+		_client, err := ethclient.DialContext(ctx, endpoint.URL)
+		if err != nil {
+
+			log.Printf("[!] Failed to connect to blockchain node `%s` : %s\n", endpoint.URL, err.Error())
+
+			e.Lock.Lock()
+			endpoint.Errors++
+			endpoint.ConsecutiveErrors++
+			endpoint.LastChecked = time.Now().UTC()
+			e.Lock.Unlock()
+			return
+
+		}
+
+		e.Lock.Lock()
+		endpoint.Client = _client
+		e.Lock.Unlock()
+
+		// Old client might still be in use, by those who got it from pool
+		// before it got replaced, so it's closed only after a while
+		if client != nil {
+			time.AfterFunc(closeDelay, client.Close)
+		}
+
+		client = _client
+
+	}
+
+	_ctx, cancel := context.WithTimeout(ctx, time.Duration(5)*time.Second)
+	defer cancel()
+
+	start := time.Now().UTC()
+	head, err := client.BlockNumber(_ctx)
+	latency := time.Now().UTC().Sub(start)
+
+	e.Lock.Lock()
+	defer e.Lock.Unlock()
+
+	endpoint.LastChecked = time.Now().UTC()
+
+	if err != nil {
+
+		log.Printf("[!] Health check failed for blockchain node `%s` : %s\n", endpoint.URL, err.Error())
+
+		endpoint.Healthy = false
+		endpoint.Errors++
+		endpoint.ConsecutiveErrors++
+		return
+
+	}
+
+	if !endpoint.Healthy {
+		log.Printf("[+] Blockchain node `%s` healthy again\n", endpoint.URL)
+	}
+
+	endpoint.Healthy = true
+	endpoint.Head = head
+	endpoint.Latency = latency
+	endpoint.ConsecutiveErrors = 0
+
+}
+
+// Check - Checks health of all endpoints in pool, concurrently
+func (e *EndpointPool) Check(ctx context.Context) {
+
+	var wg sync.WaitGroup
+
+	for _, v := range e.Endpoints {
+
+		wg.Add(1)
+
+		go func(endpoint *Endpoint) {
+			defer wg.Done()
+
+			e.check(ctx, endpoint)
+		}(v)
+
+	}
+
+	wg.Wait()
+
+}
+
+// BlockChainNodeConnection - Holds network connection objects for blockchain nodes
+//
+// Use `RPC` i.e. HTTP based connections, for querying blockchain for data
+// Use `Websocket` for real-time listening of events in blockchain
+//
+// Each of them being pool of endpoints, where best one is to be
+// picked up, every time some job needs to be performed
+type BlockChainNodeConnection struct {
+	RPC       *EndpointPool
+	Websocket *EndpointPool
+}
+
+// HealthCheck - Periodically checks health of all endpoints, to be run
+// as an independent go routine, until context gets cancelled
+func (b *BlockChainNodeConnection) HealthCheck(ctx context.Context) {
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-time.After(time.Duration(cfg.GetHealthCheckInterval()) * time.Second):
+
+			b.RPC.Check(ctx)
+			b.Websocket.Check(ctx)
+
+		}
+
+	}
+
+}
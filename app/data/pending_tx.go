@@ -0,0 +1,47 @@
+package data
+
+import (
+	"encoding/json"
+)
+
+const (
+	// PendingStatus - Tx just seen in mempool of blockchain node
+	PendingStatus = "pending"
+	// MinedStatus - Previously seen pending tx got included in block
+	MinedStatus = "mined"
+	// ReplacedStatus - Some other tx, sent from same account with same nonce,
+	// got mined, in place of previously seen pending tx
+	ReplacedStatus = "replaced"
+	// DroppedStatus - Previously seen pending tx is no more known to
+	// blockchain node & it never got mined
+	DroppedStatus = "dropped"
+)
+
+// PendingTransaction - Pending tx, seen in mempool of blockchain node, to be
+// delivered to client in this format
+//
+// Follow up messages i.e. `mined`, `replaced` & `dropped` carry only identifying
+// fields of tx, along with block info/ replacement tx hash
+type PendingTransaction struct {
+	Status               string `json:"status"`
+	Hash                 string `json:"hash"`
+	From                 string `json:"from"`
+	To                   string `json:"to"`
+	Nonce                uint64 `json:"nonce"`
+	Type                 uint8  `json:"type,omitempty"`
+	Value                string `json:"value,omitempty"`
+	Data                 string `json:"data,omitempty"`
+	Gas                  uint64 `json:"gas,omitempty"`
+	GasPrice             string `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas,omitempty"`
+	BlockHash            string `json:"blockHash,omitempty"`
+	BlockNumber          uint64 `json:"blockNumber,omitempty"`
+	ReplacedBy           string `json:"replacedBy,omitempty"`
+}
+
+// MarshalBinary - Implementing binary marshalling function, to be invoked
+// by redis before publishing data on channel
+func (p *PendingTransaction) MarshalBinary() ([]byte, error) {
+	return json.Marshal(p)
+}
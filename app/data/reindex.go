@@ -0,0 +1,109 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+const (
+	// ReindexUnchanged - Block in database matches with what's on chain
+	ReindexUnchanged = "unchanged"
+	// ReindexInserted - Block was missing from database, so it's inserted
+	ReindexInserted = "inserted"
+	// ReindexUpdated - Block with same hash was present in database, but some
+	// of its rows were missing/ different, which are fixed
+	ReindexUpdated = "updated"
+	// ReindexReplaced - Block present in database had different hash, so it's
+	// replaced with canonical one, along with all of its rows
+	ReindexReplaced = "replaced"
+	// ReindexFailed - Block couldn't be re-indexed
+	ReindexFailed = "failed"
+)
+
+// RowDiff - How many rows of some kind were found missing from database,
+// present in database but not on chain & present in both, but different
+type RowDiff struct {
+	Missing    uint64 `json:"missing"`
+	Extra      uint64 `json:"extra"`
+	Mismatched uint64 `json:"mismatched"`
+}
+
+// Changed - Whether anything needs to be changed in database or not
+func (r *RowDiff) Changed() bool {
+	return r.Missing != 0 || r.Extra != 0 || r.Mismatched != 0
+}
+
+// ReindexReport - What was changed in database, when single
+// block was re-indexed
+type ReindexReport struct {
+	Number               uint64  `json:"number"`
+	Status               string  `json:"status"`
+	OldHash              string  `json:"oldHash,omitempty"`
+	NewHash              string  `json:"newHash,omitempty"`
+	Header               bool    `json:"header"`
+	Transactions         RowDiff `json:"transactions"`
+	Events               RowDiff `json:"events"`
+	TokenTransfers       RowDiff `json:"tokenTransfers"`
+	InternalTransactions RowDiff `json:"internalTransactions"`
+	Error                string  `json:"error,omitempty"`
+}
+
+// Changed - Whether block header or any of its rows were found
+// to be different than what's on chain
+func (r *ReindexReport) Changed() bool {
+	return r.Header ||
+		r.Transactions.Changed() ||
+		r.Events.Changed() ||
+		r.TokenTransfers.Changed() ||
+		r.InternalTransactions.Changed()
+}
+
+// ReindexSummary - Outcome of re-indexing block range, where only blocks
+// which were changed or failed to be re-indexed, are reported in detail
+type ReindexSummary struct {
+	From      uint64           `json:"from"`
+	To        uint64           `json:"to"`
+	Unchanged uint64           `json:"unchanged"`
+	Inserted  uint64           `json:"inserted"`
+	Updated   uint64           `json:"updated"`
+	Replaced  uint64           `json:"replaced"`
+	Failed    uint64           `json:"failed"`
+	Blocks    []*ReindexReport `json:"blocks"`
+}
+
+// Add - Accounts for outcome of re-indexing single block
+func (r *ReindexSummary) Add(report *ReindexReport) {
+
+	switch report.Status {
+
+	case ReindexUnchanged:
+		r.Unchanged++
+		return
+	case ReindexInserted:
+		r.Inserted++
+	case ReindexUpdated:
+		r.Updated++
+	case ReindexReplaced:
+		r.Replaced++
+	case ReindexFailed:
+		r.Failed++
+
+	}
+
+	r.Blocks = append(r.Blocks, report)
+
+}
+
+// ToJSON - Encodes into JSON, to be written to report file/ sent
+// in response of admin request
+func (r *ReindexSummary) ToJSON() []byte {
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		log.Printf("[!] Failed to encode re-index report to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
@@ -0,0 +1,36 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// Reorg - Chain reorganization related info to be delivered to client in this format
+//
+// All blocks above `Ancestor` were rolled back i.e. `Orphaned` ones are no more
+// part of canonical chain, while `Canonical` ones to be processed again
+type Reorg struct {
+	Ancestor  uint64   `json:"ancestor"`
+	Depth     uint64   `json:"depth"`
+	OldHead   string   `json:"oldHead"`
+	NewHead   string   `json:"newHead"`
+	Orphaned  []string `json:"orphaned"`
+	Canonical []string `json:"canonical"`
+}
+
+// MarshalBinary - Implementing binary marshalling function, to be invoked
+// by redis before publishing data on channel
+func (r *Reorg) MarshalBinary() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// ToJSON - Encodes into JSON, to be delivered to client
+func (r *Reorg) ToJSON() []byte {
+	data, err := json.Marshal(r)
+	if err != nil {
+		log.Printf("[!] Failed to encode reorg data to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
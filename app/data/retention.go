@@ -0,0 +1,26 @@
+package data
+
+// PruneReport - Rows removed from each table, by retention service
+type PruneReport struct {
+	Blocks               int64 `json:"blocks"`
+	Transactions         int64 `json:"transactions"`
+	Events               int64 `json:"events"`
+	TokenTransfers       int64 `json:"tokenTransfers"`
+	InternalTransactions int64 `json:"internalTransactions"`
+}
+
+// Add - Accounts for rows removed in another batch
+func (p *PruneReport) Add(other *PruneReport) {
+
+	p.Blocks += other.Blocks
+	p.Transactions += other.Transactions
+	p.Events += other.Events
+	p.TokenTransfers += other.TokenTransfers
+	p.InternalTransactions += other.InternalTransactions
+
+}
+
+// Empty - Whether nothing was removed
+func (p *PruneReport) Empty() bool {
+	return p.Blocks == 0 && p.Transactions == 0 && p.Events == 0 && p.TokenTransfers == 0 && p.InternalTransactions == 0
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// TokenTransfer - Token transfer, decoded from standard event log, to be
+// delivered to client in this format
+//
+// For ERC-20 transfers `tokenId` is empty, while for ERC-721 `amount` is always 1
+type TokenTransfer struct {
+	Token           string `json:"token" gorm:"column:token"`
+	Standard        string `json:"standard" gorm:"column:standard"`
+	From            string `json:"from" gorm:"column:from"`
+	To              string `json:"to" gorm:"column:to"`
+	TokenID         string `json:"tokenId" gorm:"column:tokenid"`
+	Amount          string `json:"amount" gorm:"column:amount"`
+	Index           uint   `json:"logIndex" gorm:"column:index"`
+	BatchIndex      uint   `json:"batchIndex" gorm:"column:batchindex"`
+	TransactionHash string `json:"txHash" gorm:"column:txhash"`
+	BlockHash       string `json:"blockHash" gorm:"column:blockhash"`
+}
+
+// MarshalBinary - Implementing binary marshalling function, to be invoked
+// by redis before publishing data on channel
+func (t *TokenTransfer) MarshalBinary() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+// ToJSON - Encodes into JSON, to be delivered to client
+func (t *TokenTransfer) ToJSON() []byte {
+	data, err := json.Marshal(t)
+	if err != nil {
+		log.Printf("[!] Failed to encode token transfer to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
+
+// TokenTransfers - A collection of token transfers, to be delivered to client in this form
+type TokenTransfers struct {
+	TokenTransfers []*TokenTransfer `json:"transfers"`
+}
+
+// ToJSON - Encodes into JSON, to be delivered to client
+func (t *TokenTransfers) ToJSON() []byte {
+	data, err := json.Marshal(t)
+	if err != nil {
+		log.Printf("[!] Failed to encode token transfers to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
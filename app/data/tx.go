@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"strings"
+
+	"github.com/lib/pq"
 )
 
 // Transaction - Transaction holder struct, to be supplied when queried using tx hash
@@ -22,6 +24,43 @@ type Transaction struct {
 	Nonce     uint64 `json:"nonce" gorm:"column:nonce"`
 	State     uint64 `json:"state" gorm:"column:state"`
 	BlockHash string `json:"blockHash" gorm:"column:blockhash"`
+
+	Type                 uint8          `json:"type" gorm:"column:type"`
+	MaxFeePerGas         string         `json:"maxFeePerGas" gorm:"column:maxfeepergas"`
+	MaxPriorityFeePerGas string         `json:"maxPriorityFeePerGas" gorm:"column:maxpriorityfeepergas"`
+	EffectiveGasPrice    string         `json:"effectiveGasPrice" gorm:"column:effectivegasprice"`
+	GasUsed              uint64         `json:"gasUsed" gorm:"column:gasused"`
+	AccessList           []byte         `json:"accessList" gorm:"column:accesslist"`
+	BlobGas              uint64         `json:"blobGas" gorm:"column:blobgas"`
+	MaxFeePerBlobGas     string         `json:"maxFeePerBlobGas" gorm:"column:maxfeeperblobgas"`
+	BlobGasPrice         string         `json:"blobGasPrice" gorm:"column:blobgasprice"`
+	BlobHashes           pq.StringArray `json:"blobHashes" gorm:"column:blobhashes;type:text[]"`
+
+	Decoded *DecodedCall `json:"decoded" gorm:"-"`
+}
+
+// AccessTuple - Address & storage slots of it, which tx declares
+// to be accessing, as per EIP-2930
+type AccessTuple struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
+// DecodeAccessList - Decodes JSON encoded access list, as persisted in database
+func DecodeAccessList(data []byte) []*AccessTuple {
+
+	accessList := make([]*AccessTuple, 0)
+
+	if len(data) == 0 {
+		return accessList
+	}
+
+	if err := json.Unmarshal(data, &accessList); err != nil {
+		log.Printf("[!] Failed to decode access list : %s\n", err.Error())
+	}
+
+	return accessList
+
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -38,17 +77,41 @@ func (t *Transaction) MarshalJSON() ([]byte, error) {
 		data = fmt.Sprintf("0x%s", _h)
 	}
 
+	// Non-blob tx(s) don't have any blob hash, still encoded as empty array
+	_blobHashes := []string(t.BlobHashes)
+	if _blobHashes == nil {
+		_blobHashes = make([]string, 0)
+	}
+
+	blobHashes, err := json.Marshal(_blobHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Typed tx related fields, common for both kind of tx(s)
+	typed := fmt.Sprintf(`"type":%d,"maxFeePerGas":%q,"maxPriorityFeePerGas":%q,"effectiveGasPrice":%q,"gasUsed":%d,"accessList":%s,"blobGas":%d,"maxFeePerBlobGas":%q,"blobGasPrice":%q,"blobHashes":%s`,
+		t.Type, t.MaxFeePerGas, t.MaxPriorityFeePerGas, t.EffectiveGasPrice, t.GasUsed,
+		RawJSON(t.AccessList), t.BlobGas, t.MaxFeePerBlobGas, t.BlobGasPrice, blobHashes)
+
+	// Decoded form is present only when asked for & ABI of
+	// invoked contract is known
+	if t.Decoded != nil {
+		if _d := t.Decoded.ToJSON(); _d != nil {
+			typed = fmt.Sprintf(`%s,"decoded":%s`, typed, _d)
+		}
+	}
+
 	// When tx doesn't create contract i.e. normal tx
 	if !strings.HasPrefix(t.Contract, "0x") {
-		return []byte(fmt.Sprintf(`{"hash":%q,"from":%q,"to":%q,"value":%q,"data":%q,"gas":%d,"gasPrice":%q,"cost":%q,"nonce":%d,"state":%d,"blockHash":%q}`,
+		return []byte(fmt.Sprintf(`{"hash":%q,"from":%q,"to":%q,"value":%q,"data":%q,"gas":%d,"gasPrice":%q,"cost":%q,"nonce":%d,"state":%d,"blockHash":%q,%s}`,
 			t.Hash, t.From, t.To, t.Value,
-			data, t.Gas, t.GasPrice, t.Cost, t.Nonce, t.State, t.BlockHash)), nil
+			data, t.Gas, t.GasPrice, t.Cost, t.Nonce, t.State, t.BlockHash, typed)), nil
 	}
 
 	// When tx creates contract
-	return []byte(fmt.Sprintf(`{"hash":%q,"from":%q,"contract":%q,"value":%q,"data":%q,"gas":%d,"gasPrice":%q,"cost":%q,"nonce":%d,"state":%d,"blockHash":%q}`,
+	return []byte(fmt.Sprintf(`{"hash":%q,"from":%q,"contract":%q,"value":%q,"data":%q,"gas":%d,"gasPrice":%q,"cost":%q,"nonce":%d,"state":%d,"blockHash":%q,%s}`,
 		t.Hash, t.From, t.Contract, t.Value,
-		data, t.Gas, t.GasPrice, t.Cost, t.Nonce, t.State, t.BlockHash)), nil
+		data, t.Gas, t.GasPrice, t.Cost, t.Nonce, t.State, t.BlockHash, typed)), nil
 
 }
 
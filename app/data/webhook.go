@@ -0,0 +1,68 @@
+package data
+
+import (
+	"net"
+	"net/url"
+)
+
+// sharedAddressSpace - Carrier grade NAT range, which is not routable on
+// public internet, same as private ones
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// WebhookPayload - Payload to be sent in post request body, when registering
+// webhook, where `apiKey` is required only when done from dashboard, because
+// otherwise it's what is sent in header
+type WebhookPayload struct {
+	APIKey string `json:"apiKey"`
+	Topic  string `json:"topic" binding:"required"`
+	URL    string `json:"url" binding:"required"`
+	Secret string `json:"secret"`
+}
+
+// IsValidURL - Checks whether webhook URL is absolute http(s) one, which can
+// be POST-ed to, while rejecting hosts given as IP address, which isn't
+// publicly routable
+//
+// Host names are checked only when connecting, after they're resolved
+func (w *WebhookPayload) IsValidURL() bool {
+
+	if len(w.URL) > 2048 {
+		return false
+	}
+
+	parsed, err := url.Parse(w.URL)
+	if err != nil {
+		return false
+	}
+
+	if !(parsed.Scheme == "http" || parsed.Scheme == "https") || parsed.Hostname() == "" {
+		return false
+	}
+
+	if ip := net.ParseIP(parsed.Hostname()); ip != nil {
+		return IsPublicIP(ip)
+	}
+
+	return true
+
+}
+
+// IsPublicIP - Checks whether IP address is publicly routable, so that webhook
+// deliveries can't be used for reaching `ette`'s own host/ network i.e. loopback,
+// private, link-local ( including cloud metadata endpoints ) & unspecified ones
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		sharedAddressSpace.Contains(ip))
+}
+
+// IsValidSecret - Checks whether secret, to be used for signing delivered
+// data, fits in what can be kept
+func (w *WebhookPayload) IsValidSecret() bool {
+	return len(w.Secret) <= 256
+}
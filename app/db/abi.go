@@ -0,0 +1,47 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+// ErrNotUploader - ABI of contract is already uploaded by someone else, so
+// it can't be replaced, because it's used for decoding data delivered to everyone
+var ErrNotUploader = errors.New("ABI uploaded by someone else")
+
+// PutABI - Persists ABI of contract, replacing previous one, if any, only when
+// it was uploaded by same user
+func PutABI(_db *gorm.DB, address common.Address, abi string, uploader common.Address) error {
+
+	res := _db.Exec(`insert into abis (address, abi, uploader, ts) values (?, ?, ?, ?)
+		on conflict (address) do update set abi = excluded.abi, ts = excluded.ts
+		where abis.uploader = excluded.uploader`,
+		address.Hex(), abi, uploader.Hex(), time.Now().UTC())
+	if res.Error != nil {
+		return res.Error
+	}
+
+	// Conflicting row is left as it's, when it's uploaded by someone else
+	if res.RowsAffected == 0 {
+		return ErrNotUploader
+	}
+
+	return nil
+
+}
+
+// GetABI - Given contract address, returns ABI of it, if uploaded
+func GetABI(_db *gorm.DB, address common.Address) *ABIs {
+
+	var abi ABIs
+
+	if err := _db.Model(&ABIs{}).Where("abis.address = ?", address.Hex()).First(&abi).Error; err != nil {
+		return nil
+	}
+
+	return &abi
+
+}
@@ -3,8 +3,10 @@ package db
 import (
 	"errors"
 	"log"
+	"time"
 
 	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/metrics"
 	q "github.com/itzmeanjan/ette/app/queue"
 	"gorm.io/gorm"
 )
@@ -27,8 +29,16 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 		return errors.New("empty block received while attempting to persist")
 	}
 
+	// Time taken to persist whole block, to be kept track of
+	start := time.Now()
+
+	if err := EnsurePartition(dbWOTx, block.Block.Number); err != nil {
+		metrics.ObserveStoreBlock(start, err)
+		return err
+	}
+
 	// -- Starting DB transaction
-	return dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
+	err := dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
 
 		blockInserted := false
 
@@ -50,6 +60,28 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 				return err
 			}
 
+			// Same height, different content, which is nothing but
+			// a reorg of depth 1, not caught by head listener
+			if persistedBlock.Hash != block.Block.Hash {
+
+				var ancestor uint64
+				if block.Block.Number > 0 {
+					ancestor = block.Block.Number - 1
+				}
+
+				if err := PutReorg(dbWTx, &Reorgs{
+					Ancestor:  ancestor,
+					Depth:     1,
+					OldHead:   persistedBlock.Hash,
+					NewHead:   block.Block.Hash,
+					OldHashes: []string{persistedBlock.Hash},
+					NewHashes: []string{block.Block.Hash},
+				}); err != nil {
+					return err
+				}
+
+			}
+
 			if err := PutBlock(dbWTx, block.Block); err != nil {
 				return err
 			}
@@ -89,6 +121,22 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 			}
 
+			for _, tt := range t.TokenTransfers {
+
+				if err := UpsertTokenTransfer(dbWTx, tt); err != nil {
+					return err
+				}
+
+			}
+
+			for _, it := range t.InternalTransactions {
+
+				if err := UpsertInternalTransaction(dbWTx, it); err != nil {
+					return err
+				}
+
+			}
+
 		}
 
 		// During 👆 flow, if we've really inserted a new block into database,
@@ -103,6 +151,9 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 	})
 	// -- Ending DB transaction
 
+	metrics.ObserveStoreBlock(start, err)
+	return err
+
 }
 
 // GetBlock - Fetch block by number, from database
@@ -126,7 +177,26 @@ func PutBlock(dbWTx *gorm.DB, block *Blocks) error {
 // DeleteBlock - Delete block entry, identified by block number, while
 // cascading all dependent entries ( i.e. in transactions/ events table )
 func DeleteBlock(dbWTx *gorm.DB, number uint64) error {
-	return dbWTx.Where("number = ?", number).Delete(&Blocks{}).Error
+	return deleteBlocks(dbWTx, "number = ?", number)
+}
+
+// deleteBlocks - Deletes all blocks matching condition, along with all rows
+// referring to them, which are removed explicitly, because foreign keys
+// aren't present when tables are partitioned
+func deleteBlocks(dbWTx *gorm.DB, query string, args ...interface{}) error {
+
+	hashes := dbWTx.Model(&Blocks{}).Select("hash").Where(query, args...)
+
+	for _, v := range []interface{}{&InternalTransactions{}, &TokenTransfers{}, &Events{}, &Transactions{}} {
+
+		if err := dbWTx.Where("blockhash in (?)", hashes).Delete(v).Error; err != nil {
+			return err
+		}
+
+	}
+
+	return dbWTx.Where(query, args...).Delete(&Blocks{}).Error
+
 }
 
 // UpdateBlock - Updating already existing block
@@ -147,6 +217,10 @@ func UpdateBlock(dbWTx *gorm.DB, block *Blocks) error {
 		"txroothash":      block.TransactionRootHash,
 		"receiptroothash": block.ReceiptRootHash,
 		"extradata":       block.ExtraData,
+		"basefee":         block.BaseFee,
+		"blobgasused":     block.BlobGasUsed,
+		"excessblobgas":   block.ExcessBlobGas,
+		"withdrawals":     block.Withdrawals,
 	}).Error
 
 }
@@ -0,0 +1,284 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/itzmeanjan/ette/app/metrics"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"gorm.io/gorm"
+)
+
+// bulkModels - Tables written to, while writing blocks in bulk, whose
+// secondary indexes can be deferred
+var bulkModels = []interface{}{&Blocks{}, &Transactions{}, &Events{}, &TokenTransfers{}, &InternalTransactions{}}
+
+// bulkTable - Rows to be copied into table, along with their columns
+type bulkTable struct {
+	name    string
+	columns []string
+	rows    [][]interface{}
+}
+
+// stringArray - Converts to plain string array, so that it can be copied, while
+// keeping it non-null, when there's nothing
+func stringArray(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+
+	return v
+}
+
+// nullableStringArray - Converts to plain string array, so that it can be copied
+func nullableStringArray(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	return v
+}
+
+// toBulkTables - Flattens blocks into rows of all tables, in order
+// they're to be written, so that foreign keys are satisfied
+func toBulkTables(blocks []*PackedBlock) []*bulkTable {
+
+	_blocks := &bulkTable{
+		name:    "blocks",
+		columns: []string{"hash", "number", "time", "parenthash", "difficulty", "gasused", "gaslimit", "nonce", "miner", "size", "stateroothash", "unclehash", "txroothash", "receiptroothash", "extradata", "basefee", "blobgasused", "excessblobgas", "withdrawals"},
+	}
+	_txs := &bulkTable{
+		name:    "transactions",
+		columns: []string{"hash", "from", "to", "contract", "value", "data", "gas", "gasprice", "cost", "nonce", "state", "blockhash", "type", "maxfeepergas", "maxpriorityfeepergas", "effectivegasprice", "gasused", "accesslist", "blobgas", "maxfeeperblobgas", "blobgasprice", "blobhashes", "blocknumber"},
+	}
+	_events := &bulkTable{
+		name:    "events",
+		columns: []string{"blockhash", "index", "origin", "topics", "data", "txhash", "blocknumber"},
+	}
+	_transfers := &bulkTable{
+		name:    "token_transfers",
+		columns: []string{"blockhash", "index", "batchindex", "token", "standard", "from", "to", "tokenid", "amount", "txhash"},
+	}
+	_internals := &bulkTable{
+		name:    "internal_transactions",
+		columns: []string{"txhash", "index", "depth", "type", "from", "to", "contract", "value", "gas", "gasused", "error", "blockhash"},
+	}
+
+	for _, b := range blocks {
+
+		v := b.Block
+		_blocks.rows = append(_blocks.rows, []interface{}{v.Hash, v.Number, v.Time, v.ParentHash, v.Difficulty, v.GasUsed, v.GasLimit, v.Nonce, v.Miner, v.Size, v.StateRootHash, v.UncleHash, v.TransactionRootHash, v.ReceiptRootHash, v.ExtraData, v.BaseFee, v.BlobGasUsed, v.ExcessBlobGas, v.Withdrawals})
+
+		for _, t := range b.Transactions {
+
+			tx := t.Tx
+			_txs.rows = append(_txs.rows, []interface{}{tx.Hash, tx.From, tx.To, tx.Contract, tx.Value, tx.Data, tx.Gas, tx.GasPrice, tx.Cost, tx.Nonce, tx.State, tx.BlockHash, tx.Type, tx.MaxFeePerGas, tx.MaxPriorityFeePerGas, tx.EffectiveGasPrice, tx.GasUsed, tx.AccessList, tx.BlobGas, tx.MaxFeePerBlobGas, tx.BlobGasPrice, nullableStringArray(tx.BlobHashes), tx.BlockNumber})
+
+			for _, e := range t.Events {
+				_events.rows = append(_events.rows, []interface{}{e.BlockHash, e.Index, e.Origin, stringArray(e.Topics), e.Data, e.TransactionHash, e.BlockNumber})
+			}
+
+			for _, tt := range t.TokenTransfers {
+				_transfers.rows = append(_transfers.rows, []interface{}{tt.BlockHash, tt.Index, tt.BatchIndex, tt.Token, tt.Standard, tt.From, tt.To, tt.TokenID, tt.Amount, tt.TransactionHash})
+			}
+
+			for _, it := range t.InternalTransactions {
+				_internals.rows = append(_internals.rows, []interface{}{it.TransactionHash, it.Index, it.Depth, it.Type, it.From, it.To, it.Contract, it.Value, it.Gas, it.GasUsed, it.Error, it.BlockHash})
+			}
+
+		}
+
+	}
+
+	return []*bulkTable{_blocks, _txs, _events, _transfers, _internals}
+
+}
+
+// PutBlocksInBulk - Persists many blocks along with all of their rows, inside
+// single database transaction, using `COPY`, returning numbers of blocks which
+// were really inserted
+//
+// Rows are first copied into temporary tables & then moved into actual ones,
+// skipping blocks already present along with all of their rows, so that blocks
+// persisted concurrently by regular path, don't make whole batch fail
+func PutBlocksInBulk(_db *gorm.DB, blocks []*PackedBlock) (inserted []uint64, err error) {
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveBulkWrite(start, err)
+	}()
+
+	for _, b := range blocks {
+
+		if err = EnsurePartition(_db, b.Block.Number); err != nil {
+			return nil, err
+		}
+
+	}
+
+	sqlDB, err := _db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	inserted = make([]uint64, 0, len(blocks))
+
+	err = conn.Raw(func(driverConn interface{}) error {
+
+		_conn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("bulk writing requires pgx driver")
+		}
+
+		tx, err := _conn.Conn().Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+
+		// Hashes of blocks really inserted, rows of only those blocks are
+		// inserted into rest of tables, so that blocks persisted concurrently
+		// by regular path, don't end up having rows of both
+		hashes := make([]string, 0, len(blocks))
+
+		for _, t := range toBulkTables(blocks) {
+
+			if len(t.rows) == 0 {
+				continue
+			}
+
+			if t.name != "blocks" && len(hashes) == 0 {
+				break
+			}
+
+			temp := fmt.Sprintf("bulk_%s", t.name)
+
+			if _, err := tx.Exec(ctx, fmt.Sprintf("create temp table %s (like %s) on commit drop", temp, t.name)); err != nil {
+				return err
+			}
+
+			if _, err := tx.CopyFrom(ctx, pgx.Identifier{temp}, t.columns, pgx.CopyFromRows(t.rows)); err != nil {
+				return err
+			}
+
+			if t.name != "blocks" {
+
+				if _, err := tx.Exec(ctx, fmt.Sprintf("insert into %s select * from %s where blockhash = any($1) on conflict do nothing", t.name, temp), hashes); err != nil {
+					return err
+				}
+
+				continue
+
+			}
+
+			rows, err := tx.Query(ctx, fmt.Sprintf("insert into %s select * from %s on conflict do nothing returning number, hash", t.name, temp))
+			if err != nil {
+				return err
+			}
+
+			for rows.Next() {
+
+				var number uint64
+				var hash string
+				if err := rows.Scan(&number, &hash); err != nil {
+					rows.Close()
+					return err
+				}
+
+				inserted = append(inserted, number)
+				hashes = append(hashes, hash)
+
+			}
+
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return err
+			}
+
+		}
+
+		return tx.Commit(ctx)
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inserted, nil
+
+}
+
+// DropIndexes - Drops all secondary indexes of tables written to, while writing
+// blocks in bulk, so that they don't slow down writes
+//
+// Primary keys & unique constraints are kept, because they're required
+// for skipping already persisted rows
+func DropIndexes(_db *gorm.DB) error {
+
+	for _, m := range bulkModels {
+
+		stmt := &gorm.Statement{DB: _db}
+		if err := stmt.Parse(m); err != nil {
+			return err
+		}
+
+		for name, idx := range stmt.Schema.ParseIndexes() {
+
+			if idx.Class == "UNIQUE" || !_db.Migrator().HasIndex(m, name) {
+				continue
+			}
+
+			if err := _db.Migrator().DropIndex(m, name); err != nil {
+				return err
+			}
+
+		}
+
+	}
+
+	return nil
+
+}
+
+// CreateIndexes - Creates all secondary indexes of tables written to, while
+// writing blocks in bulk, which are not present
+//
+// Same is done during start up, while migrating, so if `ette` stops before
+// getting to invoke it, indexes get created when it's started next time
+func CreateIndexes(_db *gorm.DB) error {
+
+	for _, m := range bulkModels {
+
+		stmt := &gorm.Statement{DB: _db}
+		if err := stmt.Parse(m); err != nil {
+			return err
+		}
+
+		for name := range stmt.Schema.ParseIndexes() {
+
+			if _db.Migrator().HasIndex(m, name) {
+				continue
+			}
+
+			if err := _db.Migrator().CreateIndex(m, name); err != nil {
+				return err
+			}
+
+		}
+
+	}
+
+	return nil
+
+}
@@ -10,8 +10,9 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-// Connect - Connecting to postgresql database
-func Connect() *gorm.DB {
+// Connect - Connecting to postgresql database, along with its read replicas,
+// if any given, to which read only queries can be routed, using `Reader`
+func Connect(replicas ...string) *gorm.DB {
 	_db, err := gorm.Open(postgres.Open(fmt.Sprintf("postgresql://%s:%s@%s:%s/%s",
 		cfg.Get("DB_USER"), cfg.Get("DB_PASSWORD"), cfg.Get("DB_HOST"),
 		cfg.Get("DB_PORT"), cfg.Get("DB_NAME"))),
@@ -23,6 +24,15 @@ func Connect() *gorm.DB {
 		log.Fatalf("[!] Failed to connect to db : %s\n", err.Error())
 	}
 
-	_db.AutoMigrate(&Blocks{}, &Transactions{}, &Events{}, &Users{}, &DeliveryHistory{}, &SubscriptionPlans{}, &SubscriptionDetails{})
+	// Partitioned tables, if asked for, must be created before migration,
+	// otherwise they'd be created without partitions
+	setUpPartitioning(_db)
+
+	_db.AutoMigrate(&Blocks{}, &Transactions{}, &Events{}, &Users{}, &DeliveryHistory{}, &Webhooks{}, &WebhookDeadLetters{}, &SubscriptionPlans{}, &SubscriptionDetails{}, &Reorgs{}, &TokenTransfers{}, &InternalTransactions{}, &ABIs{}, &QueuedBlocks{}, &IntegrityFindings{}, &IngestionFilters{})
+
+	if len(replicas) != 0 {
+		connectReplicas(_db, replicas)
+	}
+
 	return _db
 }
@@ -4,7 +4,6 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 // UpsertEvent - It may be the case previously this block was processed
@@ -20,7 +19,7 @@ func UpsertEvent(dbWTx *gorm.DB, event *Events) error {
 		return errors.New("empty event received while attempting to persist")
 	}
 
-	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(event).Error
+	return dbWTx.Clauses(onConflict(dbWTx, event, "blockhash", "index")).Create(event).Error
 
 }
 
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetIngestionFilter - Reads ingestion filter, blocks were last indexed with,
+// returns nil with no error, when it's not yet known
+func GetIngestionFilter(_db *gorm.DB) (*IngestionFilters, error) {
+
+	var filter IngestionFilters
+
+	if err := _db.Model(&IngestionFilters{}).Where("id = 1").First(&filter).Error; err != nil {
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+
+	}
+
+	return &filter, nil
+
+}
+
+// PutIngestionFilter - Remembers ingestion filter, blocks are being indexed
+// with, replacing previous one
+func PutIngestionFilter(_db *gorm.DB, addresses []string, contracts []string, topics []string) error {
+
+	return _db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&IngestionFilters{
+		ID:        1,
+		Addresses: addresses,
+		Contracts: contracts,
+		Topics:    topics,
+		TimeStamp: time.Now().UTC(),
+	}).Error
+
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ParentHashFinding - Parent hash of block doesn't match with hash
+	// of previous block
+	ParentHashFinding = "parent_hash"
+	// TxRootFinding - Whether tx(s) are persisted for block or not, doesn't
+	// agree with its tx root
+	TxRootFinding = "tx_root"
+	// ReceiptRootFinding - Whether tx(s) are persisted for block or not,
+	// doesn't agree with its receipt root
+	ReceiptRootFinding = "receipt_root"
+	// NodeTxRootFinding - Tx root recomputed from tx(s) packed in block, as
+	// seen by blockchain node, doesn't match with persisted one
+	NodeTxRootFinding = "node_tx_root"
+	// NodeReceiptRootFinding - Receipt root recomputed from receipts of tx(s)
+	// packed in block, as seen by blockchain node, doesn't match with persisted one
+	NodeReceiptRootFinding = "node_receipt_root"
+	// GasUsedFinding - Sum of gas used by persisted tx(s) doesn't match
+	// with gas used by block
+	GasUsedFinding = "gas_used"
+	// LogIndexFinding - Persisted event logs of block don't have contiguous
+	// log indices, starting from 0
+	LogIndexFinding = "log_index"
+	// BlockHashFinding - Persisted block's hash doesn't match with hash of
+	// block at same height, as seen by blockchain node
+	BlockHashFinding = "block_hash"
+	// TxSetFinding - Persisted tx(s) of block don't match with tx(s)
+	// packed in block, as seen by blockchain node
+	TxSetFinding = "tx_set"
+)
+
+// BlockRowStats - Aggregated info about rows persisted for block, in
+// transactions/ events table
+type BlockRowStats struct {
+	BlockHash string `gorm:"column:blockhash"`
+	Count     uint64 `gorm:"column:count"`
+	GasUsed   uint64 `gorm:"column:gasused"`
+	MinIndex  uint64 `gorm:"column:minindex"`
+	MaxIndex  uint64 `gorm:"column:maxindex"`
+}
+
+// GetBlocksInRange - Returns all blocks persisted in database, with number
+// in [from, to] range, in ascending order of block number
+func GetBlocksInRange(_db *gorm.DB, from uint64, to uint64) []*Blocks {
+	var blocks []*Blocks
+
+	if err := _db.Model(&Blocks{}).Where("number >= ? and number <= ?", from, to).Order("number asc").Find(&blocks).Error; err != nil {
+		return nil
+	}
+
+	return blocks
+}
+
+// GetTransactionStatsByBlockHashes - Returns how many tx(s) are persisted for
+// each of given blocks & how much gas they used, keyed by block hash
+//
+// Blocks having no tx(s) persisted, are not present in returned map
+func GetTransactionStatsByBlockHashes(_db *gorm.DB, hashes []string) map[string]*BlockRowStats {
+	var stats []*BlockRowStats
+
+	if err := _db.Raw("select blockhash, count(*) as count, coalesce(sum(gasused), 0) as gasused from transactions where blockhash in ? group by blockhash", hashes).Scan(&stats).Error; err != nil {
+		return nil
+	}
+
+	_stats := make(map[string]*BlockRowStats, len(stats))
+	for _, v := range stats {
+		_stats[v.BlockHash] = v
+	}
+
+	return _stats
+}
+
+// GetEventStatsByBlockHashes - Returns how many event logs are persisted for
+// each of given blocks & what's lowest/ highest log index among them, keyed
+// by block hash
+//
+// Blocks having no event logs persisted, are not present in returned map
+func GetEventStatsByBlockHashes(_db *gorm.DB, hashes []string) map[string]*BlockRowStats {
+	var stats []*BlockRowStats
+
+	if err := _db.Raw("select blockhash, count(*) as count, min(index) as minindex, max(index) as maxindex from events where blockhash in ? group by blockhash", hashes).Scan(&stats).Error; err != nil {
+		return nil
+	}
+
+	_stats := make(map[string]*BlockRowStats, len(stats))
+	for _, v := range stats {
+		_stats[v.BlockHash] = v
+	}
+
+	return _stats
+}
+
+// GetTransactionHashesByBlockHash - Returns hashes of all tx(s) persisted for block
+func GetTransactionHashesByBlockHash(_db *gorm.DB, hash string) []string {
+	var hashes []string
+
+	if err := _db.Model(&Transactions{}).Where("blockhash = ?", hash).Pluck("hash", &hashes).Error; err != nil {
+		return nil
+	}
+
+	return hashes
+}
+
+// PutIntegrityFindings - Replaces all findings of blocks in [from, to] range,
+// with latest ones, inside a single database transaction
+func PutIntegrityFindings(dbWOTx *gorm.DB, from uint64, to uint64, findings []*IntegrityFindings) error {
+
+	return dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := dbWTx.Where("number >= ? and number <= ?", from, to).Delete(&IntegrityFindings{}).Error; err != nil {
+			return err
+		}
+
+		if len(findings) == 0 {
+			return nil
+		}
+
+		ts := time.Now().UTC()
+		for _, v := range findings {
+			v.TimeStamp = ts
+		}
+
+		return dbWTx.CreateInBatches(findings, 1000).Error
+
+	})
+
+}
+
+// GetIntegrityFindingCounts - Returns how many findings are present, keyed by kind
+func GetIntegrityFindingCounts(_db *gorm.DB) map[string]uint64 {
+	var counts []struct {
+		Kind  string `gorm:"column:kind"`
+		Count uint64 `gorm:"column:count"`
+	}
+
+	if err := _db.Raw("select kind, count(*) as count from integrity_findings group by kind").Scan(&counts).Error; err != nil {
+		return nil
+	}
+
+	_counts := make(map[string]uint64, len(counts))
+	for _, v := range counts {
+		_counts[v.Kind] = v.Count
+	}
+
+	return _counts
+}
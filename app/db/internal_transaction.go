@@ -0,0 +1,20 @@
+package db
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertInternalTransaction - Internal tx, obtained by tracing tx, to be persisted,
+// while updating all fields if it was already persisted, due to chain reorganization
+func UpsertInternalTransaction(dbWTx *gorm.DB, tx *InternalTransactions) error {
+
+	if tx == nil {
+		return errors.New("empty internal tx received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(tx).Error
+
+}
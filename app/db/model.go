@@ -4,11 +4,27 @@ import (
 	"bytes"
 	"encoding/json"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
 )
 
+// equalStrings - Checking whether two string arrays are exactly similar or not
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Tabler - ...
 type Tabler interface {
 	TableName() string
@@ -16,23 +32,29 @@ type Tabler interface {
 
 // Blocks - Mined block info holder table model
 type Blocks struct {
-	Hash                string       `gorm:"column:hash;type:char(66);primaryKey"`
-	Number              uint64       `gorm:"column:number;type:bigint;not null;unique;index:,sort:asc"`
-	Time                uint64       `gorm:"column:time;type:bigint;not null;index:,sort:asc"`
-	ParentHash          string       `gorm:"column:parenthash;type:char(66);not null"`
-	Difficulty          string       `gorm:"column:difficulty;type:varchar;not null"`
-	GasUsed             uint64       `gorm:"column:gasused;type:bigint;not null"`
-	GasLimit            uint64       `gorm:"column:gaslimit;type:bigint;not null"`
-	Nonce               string       `gorm:"column:nonce;type:varchar;not null"`
-	Miner               string       `gorm:"column:miner;type:char(42);not null"`
-	Size                float64      `gorm:"column:size;type:float(8);not null"`
-	StateRootHash       string       `gorm:"column:stateroothash;type:char(66);not null"`
-	UncleHash           string       `gorm:"column:unclehash;type:char(66);not null"`
-	TransactionRootHash string       `gorm:"column:txroothash;type:char(66);not null"`
-	ReceiptRootHash     string       `gorm:"column:receiptroothash;type:char(66);not null"`
-	ExtraData           []byte       `gorm:"column:extradata;type:bytea"`
-	Transactions        Transactions `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
-	Events              Events       `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Hash                 string               `gorm:"column:hash;type:char(66);primaryKey"`
+	Number               uint64               `gorm:"column:number;type:bigint;not null;unique;index:,sort:asc"`
+	Time                 uint64               `gorm:"column:time;type:bigint;not null;index:,sort:asc"`
+	ParentHash           string               `gorm:"column:parenthash;type:char(66);not null"`
+	Difficulty           string               `gorm:"column:difficulty;type:varchar;not null"`
+	GasUsed              uint64               `gorm:"column:gasused;type:bigint;not null"`
+	GasLimit             uint64               `gorm:"column:gaslimit;type:bigint;not null"`
+	Nonce                string               `gorm:"column:nonce;type:varchar;not null"`
+	Miner                string               `gorm:"column:miner;type:char(42);not null"`
+	Size                 float64              `gorm:"column:size;type:float(8);not null"`
+	StateRootHash        string               `gorm:"column:stateroothash;type:char(66);not null"`
+	UncleHash            string               `gorm:"column:unclehash;type:char(66);not null"`
+	TransactionRootHash  string               `gorm:"column:txroothash;type:char(66);not null"`
+	ReceiptRootHash      string               `gorm:"column:receiptroothash;type:char(66);not null"`
+	ExtraData            []byte               `gorm:"column:extradata;type:bytea"`
+	BaseFee              string               `gorm:"column:basefee;type:varchar"`
+	BlobGasUsed          uint64               `gorm:"column:blobgasused;type:bigint;not null;default:0"`
+	ExcessBlobGas        uint64               `gorm:"column:excessblobgas;type:bigint;not null;default:0"`
+	Withdrawals          []byte               `gorm:"column:withdrawals;type:json"`
+	Transactions         Transactions         `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Events               Events               `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	TokenTransfers       TokenTransfers       `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	InternalTransactions InternalTransactions `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
 }
 
 // TableName - Overriding default table name
@@ -56,7 +78,29 @@ func (b *Blocks) SimilarTo(_b *Blocks) bool {
 		b.UncleHash == _b.UncleHash &&
 		b.TransactionRootHash == _b.TransactionRootHash &&
 		b.ReceiptRootHash == _b.ReceiptRootHash &&
-		bytes.Equal(b.ExtraData, _b.ExtraData)
+		bytes.Equal(b.ExtraData, _b.ExtraData) &&
+		b.BaseFee == _b.BaseFee &&
+		b.BlobGasUsed == _b.BlobGasUsed &&
+		b.ExcessBlobGas == _b.ExcessBlobGas &&
+		sameWithdrawals(b.Withdrawals, _b.Withdrawals)
+}
+
+// sameWithdrawals - Checks whether both JSON encoded withdrawals are same, where
+// having none is considered same as having empty list, because snapshots can't
+// tell them apart
+func sameWithdrawals(a []byte, b []byte) bool {
+
+	isEmpty := func(v []byte) bool {
+		v = bytes.TrimSpace(v)
+		return len(v) == 0 || bytes.Equal(v, []byte("[]"))
+	}
+
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+
+	return bytes.Equal(a, b)
+
 }
 
 // Transactions - Blockchain transaction holder table model
@@ -73,7 +117,22 @@ type Transactions struct {
 	Nonce     uint64 `gorm:"column:nonce;type:bigint;not null;index"`
 	State     uint64 `gorm:"column:state;type:smallint;not null"`
 	BlockHash string `gorm:"column:blockhash;type:char(66);not null;index"`
-	Events    Events `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
+
+	Type                 uint8          `gorm:"column:type;type:smallint;not null;default:0"`
+	MaxFeePerGas         string         `gorm:"column:maxfeepergas;type:varchar"`
+	MaxPriorityFeePerGas string         `gorm:"column:maxpriorityfeepergas;type:varchar"`
+	EffectiveGasPrice    string         `gorm:"column:effectivegasprice;type:varchar"`
+	GasUsed              uint64         `gorm:"column:gasused;type:bigint;not null;default:0"`
+	AccessList           []byte         `gorm:"column:accesslist;type:json"`
+	BlobGas              uint64         `gorm:"column:blobgas;type:bigint;not null;default:0"`
+	MaxFeePerBlobGas     string         `gorm:"column:maxfeeperblobgas;type:varchar"`
+	BlobGasPrice         string         `gorm:"column:blobgasprice;type:varchar"`
+	BlobHashes           pq.StringArray `gorm:"column:blobhashes;type:text[]"`
+	BlockNumber          uint64         `gorm:"column:blocknumber;type:bigint;not null;default:0"`
+
+	Events               Events               `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
+	TokenTransfers       TokenTransfers       `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
+	InternalTransactions InternalTransactions `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
 }
 
 // TableName - Overriding default table name
@@ -81,6 +140,35 @@ func (Transactions) TableName() string {
 	return "transactions"
 }
 
+// SimilarTo - Checking whether two tx(s) are exactly similar or not
+//
+// Address columns are fixed width, so empty ones come back
+// padded from database, which are trimmed before comparing
+func (t *Transactions) SimilarTo(_t *Transactions) bool {
+	return t.Hash == _t.Hash &&
+		t.From == _t.From &&
+		strings.TrimSpace(t.To) == strings.TrimSpace(_t.To) &&
+		strings.TrimSpace(t.Contract) == strings.TrimSpace(_t.Contract) &&
+		t.Value == _t.Value &&
+		bytes.Equal(t.Data, _t.Data) &&
+		t.Gas == _t.Gas &&
+		t.GasPrice == _t.GasPrice &&
+		t.Cost == _t.Cost &&
+		t.Nonce == _t.Nonce &&
+		t.State == _t.State &&
+		t.BlockHash == _t.BlockHash &&
+		t.Type == _t.Type &&
+		t.MaxFeePerGas == _t.MaxFeePerGas &&
+		t.MaxPriorityFeePerGas == _t.MaxPriorityFeePerGas &&
+		t.EffectiveGasPrice == _t.EffectiveGasPrice &&
+		t.GasUsed == _t.GasUsed &&
+		bytes.Equal(t.AccessList, _t.AccessList) &&
+		t.BlobGas == _t.BlobGas &&
+		t.MaxFeePerBlobGas == _t.MaxFeePerBlobGas &&
+		t.BlobGasPrice == _t.BlobGasPrice &&
+		equalStrings(t.BlobHashes, _t.BlobHashes)
+}
+
 // Events - Events emitted from smart contracts to be held in this table
 type Events struct {
 	BlockHash       string         `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
@@ -89,6 +177,7 @@ type Events struct {
 	Topics          pq.StringArray `gorm:"column:topics;type:text[];not null;index:,type:gin"`
 	Data            []byte         `gorm:"column:data;type:bytea"`
 	TransactionHash string         `gorm:"column:txhash;type:char(66);not null;index"`
+	BlockNumber     uint64         `gorm:"column:blocknumber;type:bigint;not null;default:0"`
 }
 
 // TableName - Overriding default table name
@@ -96,11 +185,194 @@ func (Events) TableName() string {
 	return "events"
 }
 
+// SimilarTo - Checking whether two event logs are exactly similar or not
+func (e *Events) SimilarTo(_e *Events) bool {
+	return e.BlockHash == _e.BlockHash &&
+		e.Index == _e.Index &&
+		e.Origin == _e.Origin &&
+		equalStrings(e.Topics, _e.Topics) &&
+		bytes.Equal(e.Data, _e.Data) &&
+		e.TransactionHash == _e.TransactionHash
+}
+
+// TokenTransfers - ERC-20, ERC-721 & ERC-1155 token transfers, decoded from
+// standard `Transfer`, `TransferSingle` & `TransferBatch` event logs
+//
+// Single `TransferBatch` log gives multiple entries, distinguished by
+// their position in batch
+type TokenTransfers struct {
+	BlockHash       string `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
+	Index           uint   `gorm:"column:index;type:integer;not null;primaryKey"`
+	BatchIndex      uint   `gorm:"column:batchindex;type:integer;not null;primaryKey"`
+	Token           string `gorm:"column:token;type:char(42);not null;index"`
+	Standard        string `gorm:"column:standard;type:varchar(10);not null"`
+	From            string `gorm:"column:from;type:char(42);not null;index"`
+	To              string `gorm:"column:to;type:char(42);not null;index"`
+	TokenID         string `gorm:"column:tokenid;type:varchar"`
+	Amount          string `gorm:"column:amount;type:varchar;not null"`
+	TransactionHash string `gorm:"column:txhash;type:char(66);not null;index"`
+}
+
+// TableName - Overriding default table name
+func (TokenTransfers) TableName() string {
+	return "token_transfers"
+}
+
+// SimilarTo - Checking whether two token transfers are exactly similar or not
+func (t *TokenTransfers) SimilarTo(_t *TokenTransfers) bool {
+	return t.BlockHash == _t.BlockHash &&
+		t.Index == _t.Index &&
+		t.BatchIndex == _t.BatchIndex &&
+		t.Token == _t.Token &&
+		t.Standard == _t.Standard &&
+		t.From == _t.From &&
+		t.To == _t.To &&
+		t.TokenID == _t.TokenID &&
+		t.Amount == _t.Amount &&
+		t.TransactionHash == _t.TransactionHash
+}
+
+// InternalTransactions - Calls made by contracts during tx execution, obtained by
+// flattening call tree, as traced by blockchain node, in depth first order
+//
+// Top level call i.e. tx itself, is not kept here, where contract creations
+// have `to` empty & created contract address in `contract`, just like tx(s)
+type InternalTransactions struct {
+	TransactionHash string `gorm:"column:txhash;type:char(66);not null;primaryKey"`
+	Index           uint   `gorm:"column:index;type:integer;not null;primaryKey"`
+	Depth           uint   `gorm:"column:depth;type:integer;not null"`
+	Type            string `gorm:"column:type;type:varchar(20);not null"`
+	From            string `gorm:"column:from;type:char(42);not null;index"`
+	To              string `gorm:"column:to;type:char(42);index"`
+	Contract        string `gorm:"column:contract;type:char(42);index"`
+	Value           string `gorm:"column:value;type:varchar;not null"`
+	Gas             uint64 `gorm:"column:gas;type:bigint;not null"`
+	GasUsed         uint64 `gorm:"column:gasused;type:bigint;not null"`
+	Error           string `gorm:"column:error;type:text"`
+	BlockHash       string `gorm:"column:blockhash;type:char(66);not null;index"`
+}
+
+// TableName - Overriding default table name
+func (InternalTransactions) TableName() string {
+	return "internal_transactions"
+}
+
+// SimilarTo - Checking whether two internal tx(s) are exactly similar or not
+func (i *InternalTransactions) SimilarTo(_i *InternalTransactions) bool {
+	return i.TransactionHash == _i.TransactionHash &&
+		i.Index == _i.Index &&
+		i.Depth == _i.Depth &&
+		i.Type == _i.Type &&
+		i.From == _i.From &&
+		strings.TrimSpace(i.To) == strings.TrimSpace(_i.To) &&
+		strings.TrimSpace(i.Contract) == strings.TrimSpace(_i.Contract) &&
+		i.Value == _i.Value &&
+		i.Gas == _i.Gas &&
+		i.GasUsed == _i.GasUsed &&
+		i.Error == _i.Error &&
+		i.BlockHash == _i.BlockHash
+}
+
+// ABIs - Contract ABIs uploaded by `ette` users, to be used for
+// decoding event logs emitted by & tx(s) sent to those contracts
+type ABIs struct {
+	Address   string    `gorm:"column:address;type:char(42);primaryKey" json:"address"`
+	ABI       string    `gorm:"column:abi;type:text;not null" json:"abi"`
+	Uploader  string    `gorm:"column:uploader;type:char(42);not null;index" json:"uploader"`
+	TimeStamp time.Time `gorm:"column:ts;type:timestamp;not null" json:"timeStamp"`
+}
+
+// TableName - Overriding default table name
+func (ABIs) TableName() string {
+	return "abis"
+}
+
+// ToJSON - Encodes into JSON, to be supplied when queried for ABI of contract
+func (a *ABIs) ToJSON() []byte {
+	data, err := json.Marshal(a)
+	if err != nil {
+		log.Printf("[!] Failed to encode contract ABI to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+}
+
+// Reorgs - Chain reorganizations detected by `ette`, where all blocks
+// above common ancestor were rolled back & canonical chain to be re-ingested
+type Reorgs struct {
+	ID        string         `gorm:"column:id;type:uuid;default:gen_random_uuid();primaryKey"`
+	Ancestor  uint64         `gorm:"column:ancestor;type:bigint;not null;index"`
+	Depth     uint64         `gorm:"column:depth;type:bigint;not null"`
+	OldHead   string         `gorm:"column:oldhead;type:char(66);not null"`
+	NewHead   string         `gorm:"column:newhead;type:char(66);not null"`
+	OldHashes pq.StringArray `gorm:"column:oldhashes;type:text[];not null"`
+	NewHashes pq.StringArray `gorm:"column:newhashes;type:text[];not null"`
+	TimeStamp time.Time      `gorm:"column:ts;type:timestamp;not null;index:,sort:asc"`
+}
+
+// TableName - Overriding default table name
+func (Reorgs) TableName() string {
+	return "reorgs"
+}
+
+// IntegrityFindings - Inconsistencies found in persisted blocks, by
+// integrity verifier, where only findings of latest verification
+// of block are kept
+type IntegrityFindings struct {
+	ID        string    `gorm:"column:id;type:uuid;default:gen_random_uuid();primaryKey" json:"-"`
+	Number    uint64    `gorm:"column:number;type:bigint;not null;index" json:"number"`
+	Hash      string    `gorm:"column:hash;type:char(66);not null" json:"hash"`
+	Kind      string    `gorm:"column:kind;type:varchar(20);not null;index" json:"kind"`
+	Detail    string    `gorm:"column:detail;type:text;not null" json:"detail"`
+	TimeStamp time.Time `gorm:"column:ts;type:timestamp;not null" json:"timeStamp"`
+}
+
+// TableName - Overriding default table name
+func (IntegrityFindings) TableName() string {
+	return "integrity_findings"
+}
+
+// QueuedBlocks - State of blocks present in block processor queue, persisted
+// so that it can be reloaded, when `ette` restarts
+type QueuedBlocks struct {
+	Number              uint64    `gorm:"column:number;type:bigint;primaryKey"`
+	UnconfirmedProgress bool      `gorm:"column:unconfirmedprogress;type:boolean;not null"`
+	Published           bool      `gorm:"column:published;type:boolean;not null"`
+	UnconfirmedDone     bool      `gorm:"column:unconfirmeddone;type:boolean;not null"`
+	ConfirmedProgress   bool      `gorm:"column:confirmedprogress;type:boolean;not null"`
+	ConfirmedDone       bool      `gorm:"column:confirmeddone;type:boolean;not null"`
+	LastAttempted       time.Time `gorm:"column:lastattempted;type:timestamp;not null"`
+	Delay               int64     `gorm:"column:delay;type:bigint;not null"`
+}
+
+// TableName - Overriding default table name
+func (QueuedBlocks) TableName() string {
+	return "queued_blocks"
+}
+
+// IngestionFilters - Ingestion filter, blocks were last indexed with, kept
+// so that changes to it can be detected, when `ette` restarts
+type IngestionFilters struct {
+	ID        uint8          `gorm:"column:id;type:smallint;primaryKey"`
+	Addresses pq.StringArray `gorm:"column:addresses;type:text[];not null"`
+	Contracts pq.StringArray `gorm:"column:contracts;type:text[];not null"`
+	Topics    pq.StringArray `gorm:"column:topics;type:text[];not null"`
+	TimeStamp time.Time      `gorm:"column:ts;type:timestamp;not null"`
+}
+
+// TableName - Overriding default table name
+func (IngestionFilters) TableName() string {
+	return "ingestion_filter"
+}
+
 // PackedTransaction - All data that is stored in a tx, to be passed from
 // tx data fetcher to whole block data persist handler function
 type PackedTransaction struct {
-	Tx     *Transactions
-	Events []*Events
+	Tx                   *Transactions
+	Events               []*Events
+	TokenTransfers       []*TokenTransfers
+	InternalTransactions []*InternalTransactions
 }
 
 // PackedBlock - Whole block data to be persisted in a single
@@ -153,6 +425,39 @@ func (DeliveryHistory) TableName() string {
 	return "delivery_history"
 }
 
+// Webhooks - Webhook subscriptions registered by users, where real-time data
+// published on topic, matching filters present in it, is POST-ed to URL,
+// being signed using secret, if any
+type Webhooks struct {
+	ID        string    `gorm:"column:id;type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	APIKey    string    `gorm:"column:apikey;type:char(66);not null;index" json:"apiKey"`
+	Topic     string    `gorm:"column:topic;type:varchar(400);not null" json:"topic"`
+	URL       string    `gorm:"column:url;type:varchar(2048);not null" json:"url"`
+	Secret    string    `gorm:"column:secret;type:varchar(256);not null" json:"-"`
+	TimeStamp time.Time `gorm:"column:ts;type:timestamp;not null" json:"timeStamp"`
+}
+
+// TableName - Overriding default table name
+func (Webhooks) TableName() string {
+	return "webhooks"
+}
+
+// WebhookDeadLetters - Data which couldn't be delivered to webhook, even after
+// retrying, kept along with why last attempt failed
+type WebhookDeadLetters struct {
+	ID        string    `gorm:"column:id;type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	Webhook   string    `gorm:"column:webhook;type:uuid;not null;index" json:"webhook"`
+	Payload   string    `gorm:"column:payload;type:text;not null" json:"payload"`
+	Attempts  uint64    `gorm:"column:attempts;type:int;not null" json:"attempts"`
+	Error     string    `gorm:"column:error;type:text;not null" json:"error"`
+	TimeStamp time.Time `gorm:"column:ts;type:timestamp;not null;index:,sort:asc" json:"timeStamp"`
+}
+
+// TableName - Overriding default table name
+func (WebhookDeadLetters) TableName() string {
+	return "webhook_dead_letters"
+}
+
 // SubscriptionPlans - Allowed subscription plans, to be auto populated from
 // .plans.json, at application start up
 type SubscriptionPlans struct {
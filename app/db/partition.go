@@ -0,0 +1,296 @@
+package db
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// partitionedTables - Tables partitioned by block number range, along with their
+// definitions, which must be kept in sync with respective models, so that
+// migration finds nothing to change
+//
+// Partition key needs to be part of every unique constraint, so primary keys
+// are extended with block number, while foreign keys referring to blocks
+// can't be kept anymore
+var partitionedTables = []struct {
+	name string
+	ddl  string
+}{
+	{
+		name: "blocks",
+		ddl: `create table if not exists blocks (
+			hash char(66) not null,
+			number bigint not null,
+			time bigint not null,
+			parenthash char(66) not null,
+			difficulty varchar not null,
+			gasused bigint not null,
+			gaslimit bigint not null,
+			nonce varchar not null,
+			miner char(42) not null,
+			size float(8) not null,
+			stateroothash char(66) not null,
+			unclehash char(66) not null,
+			txroothash char(66) not null,
+			receiptroothash char(66) not null,
+			extradata bytea,
+			basefee varchar,
+			blobgasused bigint not null default 0,
+			excessblobgas bigint not null default 0,
+			withdrawals json,
+			primary key (hash, number),
+			unique (number)
+		) partition by range (number)`,
+	},
+	{
+		name: "transactions",
+		ddl: `create table if not exists transactions (
+			hash char(66) not null,
+			"from" char(42) not null,
+			"to" char(42),
+			contract char(42),
+			value varchar,
+			data bytea,
+			gas bigint not null,
+			gasprice varchar not null,
+			cost varchar not null,
+			nonce bigint not null,
+			state smallint not null,
+			blockhash char(66) not null,
+			type smallint not null default 0,
+			maxfeepergas varchar,
+			maxpriorityfeepergas varchar,
+			effectivegasprice varchar,
+			gasused bigint not null default 0,
+			accesslist json,
+			blobgas bigint not null default 0,
+			maxfeeperblobgas varchar,
+			blobgasprice varchar,
+			blobhashes text[],
+			blocknumber bigint not null default 0,
+			primary key (hash, blocknumber)
+		) partition by range (blocknumber)`,
+	},
+	{
+		name: "events",
+		ddl: `create table if not exists events (
+			blockhash char(66) not null,
+			"index" integer not null,
+			origin char(42) not null,
+			topics text[] not null,
+			data bytea,
+			txhash char(66) not null,
+			blocknumber bigint not null default 0,
+			primary key (blockhash, "index", blocknumber)
+		) partition by range (blocknumber)`,
+	},
+}
+
+var (
+	// partitioned - Whether tables are partitioned by block number range,
+	// as found during start up
+	partitioned bool
+
+	// partitions - Partitions already known to be present, by their index
+	partitions     = make(map[uint64]bool)
+	partitionsLock = &sync.Mutex{}
+)
+
+// isPartitioned - Checks whether table exists & is partitioned
+func isPartitioned(_db *gorm.DB, table string) bool {
+
+	var count int64
+
+	if err := _db.Raw("select count(*) from pg_partitioned_table where partrelid = to_regclass(?)", table).Scan(&count).Error; err != nil {
+		log.Printf("[!] Failed to check whether `%s` is partitioned : %s\n", table, err.Error())
+		return false
+	}
+
+	return count == 1
+
+}
+
+// setUpPartitioning - Creates partitioned tables, if asked for & not yet present,
+// before they get migrated, finding out whether they're partitioned or not
+//
+// Already existing tables are never converted, rather they're used as they are,
+// so partitioning can only be enabled while starting with new database
+func setUpPartitioning(_db *gorm.DB) {
+
+	if cfg.IsPartitioningEnabled() {
+
+		for _, v := range partitionedTables {
+
+			if _db.Migrator().HasTable(v.name) && !isPartitioned(_db, v.name) {
+				log.Printf("[!] Table `%s` already exists without partitions, can't be partitioned\n", v.name)
+				continue
+			}
+
+			if err := _db.Exec(v.ddl).Error; err != nil {
+				log.Printf("[!] Failed to create partitioned table `%s` : %s\n", v.name, err.Error())
+			}
+
+		}
+
+	}
+
+	partitioned = true
+	for _, v := range partitionedTables {
+		partitioned = partitioned && isPartitioned(_db, v.name)
+	}
+
+	if !partitioned {
+
+		if cfg.IsPartitioningEnabled() {
+			log.Printf("[!] Tables aren't partitioned, proceeding without partitions\n")
+		}
+
+		return
+
+	}
+
+	if !cfg.IsPartitioningEnabled() {
+		log.Printf("[*] Tables are partitioned, proceeding with partitions\n")
+	}
+
+	// Foreign keys referring to blocks can't be created, because block
+	// hash alone isn't unique anymore
+	_db.Config.DisableForeignKeyConstraintWhenMigrating = true
+
+}
+
+// EnsurePartition - Makes sure partitions holding given block & next range of
+// blocks are present, so that new partition is ready before chain reaches it
+//
+// Invoked before writing block, which does nothing when tables aren't
+// partitioned or partitions are already known to be present
+func EnsurePartition(_db *gorm.DB, number uint64) error {
+
+	if !partitioned {
+		return nil
+	}
+
+	size := cfg.GetPartitionSize()
+	index := number / size
+
+	partitionsLock.Lock()
+	defer partitionsLock.Unlock()
+
+	for _, i := range []uint64{index, index + 1} {
+
+		if partitions[i] {
+			continue
+		}
+
+		for _, v := range partitionedTables {
+
+			if err := _db.Exec(fmt.Sprintf("create table if not exists %s_p%d partition of %s for values from (%d) to (%d)", v.name, i, v.name, i*size, (i+1)*size)).Error; err != nil {
+				return err
+			}
+
+		}
+
+		partitions[i] = true
+
+	}
+
+	return nil
+
+}
+
+// onConflict - Upsert clause, updating all columns of row, when primary key
+// conflicts, which is extended with block number, when tables are partitioned
+//
+// Conflict target of `UpdateAll` is always primary key of model, so columns
+// to be updated are listed explicitly, when partitioned
+func onConflict(_db *gorm.DB, model interface{}, keys ...string) clause.OnConflict {
+
+	if !partitioned {
+		return clause.OnConflict{UpdateAll: true}
+	}
+
+	keys = append(keys, "blocknumber")
+
+	stmt := &gorm.Statement{DB: _db}
+	if err := stmt.Parse(model); err != nil {
+		return clause.OnConflict{UpdateAll: true}
+	}
+
+	columns := make([]clause.Column, len(keys))
+	for k, v := range keys {
+		columns[k] = clause.Column{Name: v}
+	}
+
+	updates := make([]string, 0, len(stmt.Schema.DBNames))
+	for _, v := range stmt.Schema.DBNames {
+
+		excluded := false
+		for _, k := range keys {
+			if v == k {
+				excluded = true
+				break
+			}
+		}
+
+		if !excluded {
+			updates = append(updates, v)
+		}
+
+	}
+
+	return clause.OnConflict{Columns: columns, DoUpdates: clause.AssignmentColumns(updates)}
+
+}
+
+// inBlockNumberRange - Keeps rows of table, in given block number range, which
+// prunes partitions not holding them, when tables are partitioned
+func inBlockNumberRange(table string, from uint64, to uint64) func(*gorm.DB) *gorm.DB {
+
+	return func(db *gorm.DB) *gorm.DB {
+
+		if partitioned {
+			return db.Where(fmt.Sprintf("%s.blocknumber >= ? and %s.blocknumber <= ?", table, table), from, to)
+		}
+
+		return db.Joins(fmt.Sprintf("left join blocks on %s.blockhash = blocks.hash", table)).Where("blocks.number >= ? and blocks.number <= ?", from, to)
+
+	}
+
+}
+
+// inBlockTimeRange - Keeps rows of table, in given block time range, which is
+// first converted to block number range, when tables are partitioned, so that
+// partitions not holding them can be pruned, while executing query
+func inBlockTimeRange(table string, from uint64, to uint64) func(*gorm.DB) *gorm.DB {
+
+	return func(db *gorm.DB) *gorm.DB {
+
+		if partitioned {
+			return db.Where(fmt.Sprintf("%s.blocknumber >= (select min(number) from blocks where time >= ? and time <= ?) and %s.blocknumber <= (select max(number) from blocks where time >= ? and time <= ?)", table, table), from, to, from, to)
+		}
+
+		return db.Joins(fmt.Sprintf("left join blocks on %s.blockhash = blocks.hash", table)).Where("blocks.time >= ? and blocks.time <= ?", from, to)
+
+	}
+
+}
+
+// inBlock - Keeps rows of table, belonging to block with given number
+func inBlock(table string, number uint64) func(*gorm.DB) *gorm.DB {
+
+	return func(db *gorm.DB) *gorm.DB {
+
+		if partitioned {
+			return db.Where(fmt.Sprintf("%s.blocknumber = ?", table), number)
+		}
+
+		return db.Where(fmt.Sprintf("%s.blockhash = (select hash from blocks where number = ?)", table), number)
+
+	}
+
+}
@@ -73,6 +73,18 @@ func GetBlockCount(db *gorm.DB) uint64 {
 	return uint64(number)
 }
 
+// GetBlockCountInRange - Returns how many blocks are present in database
+// in given block number range, both inclusive
+func GetBlockCountInRange(db *gorm.DB, from uint64, to uint64) uint64 {
+	var number int64
+
+	if err := db.Model(&Blocks{}).Where("number >= ? and number <= ?", from, to).Count(&number).Error; err != nil {
+		return 0
+	}
+
+	return uint64(number)
+}
+
 // GetBlockByHash - Given blockhash finds out block related information
 //
 // If not found, returns nil
@@ -168,7 +180,7 @@ func GetTransactionCountByBlockNumber(db *gorm.DB, number uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Where("blockhash = (?)", db.Model(&Blocks{}).Where("number = ?", number).Select("hash")).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Scopes(inBlock("transactions", number)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -181,7 +193,7 @@ func GetTransactionCountByBlockNumber(db *gorm.DB, number uint64) int64 {
 func GetTransactionsByBlockNumber(db *gorm.DB, number uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if res := db.Model(&Transactions{}).Where("blockhash = (?)", db.Model(&Blocks{}).Where("number = ?", number).Select("hash")).Find(&tx); res.Error != nil {
+	if res := db.Model(&Transactions{}).Scopes(inBlock("transactions", number)).Find(&tx); res.Error != nil {
 		return nil
 	}
 
@@ -207,7 +219,7 @@ func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, account commo
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ?", account.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -220,7 +232,7 @@ func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, account commo
 func GetTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ?", account.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -235,7 +247,7 @@ func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, account common.
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ?", account.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -248,7 +260,7 @@ func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, account common.
 func GetTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ?", account.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -263,7 +275,7 @@ func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, account common.
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.to = ?", account.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -276,7 +288,7 @@ func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, account common.
 func GetTransactionsToAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.to = ?", account.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -291,7 +303,7 @@ func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, account common.Ad
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.to = ?", account.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -304,7 +316,7 @@ func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, account common.Ad
 func GetTransactionsToAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.to = ?", account.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -319,7 +331,7 @@ func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccou
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.to = ?", fromAccount.Hex(), toAccount.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -332,7 +344,7 @@ func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccou
 func GetTransactionsBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.to = ?", fromAccount.Hex(), toAccount.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -347,7 +359,7 @@ func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.to = ?", fromAccount.Hex(), toAccount.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -360,7 +372,7 @@ func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount
 func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.to = ?", fromAccount.Hex(), toAccount.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -374,7 +386,7 @@ func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount com
 func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.contract <> '' and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.contract <> ''", account.Hex()).Scopes(inBlockNumberRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -388,7 +400,7 @@ func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, a
 func GetContractCreationTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.contract <> '' and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.contract <> ''", account.Hex()).Scopes(inBlockTimeRange("transactions", from, to)).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.accesslist, transactions.blobgas, transactions.maxfeeperblobgas, transactions.blobgasprice, transactions.blobhashes").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -414,7 +426,7 @@ func GetEventsFromContractByBlockNumberRange(db *gorm.DB, contract common.Addres
 
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.origin = ? and blocks.number >= ? and blocks.number <= ?", contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
+	if err := db.Model(&Events{}).Where("events.origin = ?", contract.Hex()).Scopes(inBlockNumberRange("events", from, to)).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -430,7 +442,7 @@ func GetEventsFromContractByBlockTimeRange(db *gorm.DB, contract common.Address,
 
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.origin = ? and blocks.time >= ? and blocks.time <= ?", contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
+	if err := db.Model(&Events{}).Where("events.origin = ?", contract.Hex()).Scopes(inBlockTimeRange("events", from, to)).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -527,10 +539,17 @@ func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, contract com
 
 	var events []*data.Event
 
+	// Block number is kept along with event logs, when tables are partitioned,
+	// so that partitions not holding them are pruned, without joining
+	join, number := "left join blocks as b on e.blockhash = b.hash", "b.number"
+	if partitioned {
+		join, number = "", "e.blocknumber"
+	}
+
 	if err := db.Raw(fmt.Sprintf(
 		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' and b.number >= %d and b.number <= %d and '{%s}' <@ e.topics",
-		contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
+			"%s where e.origin = '%s' and %s >= %d and %s <= %d and '{%s}' <@ e.topics",
+		join, contract.Hex(), number, from, number, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
 		return nil
 	}
 
@@ -548,10 +567,17 @@ func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, contract commo
 
 	var events []*data.Event
 
+	// Time range is converted to block number range, when tables are
+	// partitioned, so that partitions not holding them are pruned
+	join, timeRange := "left join blocks as b on e.blockhash = b.hash", fmt.Sprintf("b.time >= %d and b.time <= %d", from, to)
+	if partitioned {
+		join, timeRange = "", fmt.Sprintf("e.blocknumber >= (select min(number) from blocks where time >= %d and time <= %d) and e.blocknumber <= (select max(number) from blocks where time >= %d and time <= %d)", from, to, from, to)
+	}
+
 	if err := db.Raw(fmt.Sprintf(
 		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' and b.time >= %d and b.time <= %d and '{%s}' <@ e.topics",
-		contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
+			"%s where e.origin = '%s' and %s and '{%s}' <@ e.topics",
+		join, contract.Hex(), timeRange, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
 		return nil
 	}
 
@@ -568,10 +594,17 @@ func GetLastXEventsFromContract(db *gorm.DB, contract common.Address, x int) *da
 
 	var events []*data.Event
 
+	// Block number is kept along with event logs, when tables are partitioned,
+	// so that partitions not holding them are pruned, without joining
+	join, number := "left join blocks as b on e.blockhash = b.hash", "b.number"
+	if partitioned {
+		join, number = "", "e.blocknumber"
+	}
+
 	if err := db.Raw(fmt.Sprintf(
 		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' order by b.number desc limit %d",
-		contract.Hex(), x)).Scan(&events).Error; err != nil {
+			"%s where e.origin = '%s' order by %s desc limit %d",
+		join, contract.Hex(), number, x)).Scan(&events).Error; err != nil {
 		return nil
 	}
 
@@ -620,3 +653,163 @@ func GetEventByBlockNumberAndLogIndex(db *gorm.DB, number uint64, index uint) *d
 	return &event
 
 }
+
+// GetTokenTransfersOfTokenByBlockNumberRange - Given token contract address & block number range, returns all transfers of
+// this token, in that block range
+func GetTokenTransfersOfTokenByBlockNumberRange(db *gorm.DB, token common.Address, from uint64, to uint64) *data.TokenTransfers {
+
+	var transfers []*data.TokenTransfer
+
+	if err := db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("token_transfers.token = ? and blocks.number >= ? and blocks.number <= ?", token.Hex(), from, to).Order("blocks.number asc, token_transfers.index asc, token_transfers.batchindex asc").Select("token_transfers.token, token_transfers.standard, token_transfers.from, token_transfers.to, token_transfers.tokenid, token_transfers.amount, token_transfers.index, token_transfers.batchindex, token_transfers.txhash, token_transfers.blockhash").Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+
+}
+
+// GetTokenTransfersOfTokenByBlockTimeRange - Given token contract address & block time range, returns all transfers of
+// this token, in that time span
+func GetTokenTransfersOfTokenByBlockTimeRange(db *gorm.DB, token common.Address, from uint64, to uint64) *data.TokenTransfers {
+
+	var transfers []*data.TokenTransfer
+
+	if err := db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("token_transfers.token = ? and blocks.time >= ? and blocks.time <= ?", token.Hex(), from, to).Order("blocks.number asc, token_transfers.index asc, token_transfers.batchindex asc").Select("token_transfers.token, token_transfers.standard, token_transfers.from, token_transfers.to, token_transfers.tokenid, token_transfers.amount, token_transfers.index, token_transfers.batchindex, token_transfers.txhash, token_transfers.blockhash").Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+
+}
+
+// GetTokenTransfersOfHolderByBlockNumberRange - Given token holder address & block number range, returns all token transfers
+// either sent from or received by this address, in that block range
+func GetTokenTransfersOfHolderByBlockNumberRange(db *gorm.DB, holder common.Address, from uint64, to uint64) *data.TokenTransfers {
+
+	var transfers []*data.TokenTransfer
+
+	if err := db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("(token_transfers.from = ? or token_transfers.to = ?) and blocks.number >= ? and blocks.number <= ?", holder.Hex(), holder.Hex(), from, to).Order("blocks.number asc, token_transfers.index asc, token_transfers.batchindex asc").Select("token_transfers.token, token_transfers.standard, token_transfers.from, token_transfers.to, token_transfers.tokenid, token_transfers.amount, token_transfers.index, token_transfers.batchindex, token_transfers.txhash, token_transfers.blockhash").Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+
+}
+
+// GetTokenTransfersOfHolderByBlockTimeRange - Given token holder address & block time range, returns all token transfers
+// either sent from or received by this address, in that time span
+func GetTokenTransfersOfHolderByBlockTimeRange(db *gorm.DB, holder common.Address, from uint64, to uint64) *data.TokenTransfers {
+
+	var transfers []*data.TokenTransfer
+
+	if err := db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("(token_transfers.from = ? or token_transfers.to = ?) and blocks.time >= ? and blocks.time <= ?", holder.Hex(), holder.Hex(), from, to).Order("blocks.number asc, token_transfers.index asc, token_transfers.batchindex asc").Select("token_transfers.token, token_transfers.standard, token_transfers.from, token_transfers.to, token_transfers.tokenid, token_transfers.amount, token_transfers.index, token_transfers.batchindex, token_transfers.txhash, token_transfers.blockhash").Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+
+}
+
+// GetTokenTransfersOfHolderForTokenByBlockNumberRange - Given token contract address, token holder address & block number range, returns
+// all transfers of this token, either sent from or received by this address, in that block range
+func GetTokenTransfersOfHolderForTokenByBlockNumberRange(db *gorm.DB, token common.Address, holder common.Address, from uint64, to uint64) *data.TokenTransfers {
+
+	var transfers []*data.TokenTransfer
+
+	if err := db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("token_transfers.token = ? and (token_transfers.from = ? or token_transfers.to = ?) and blocks.number >= ? and blocks.number <= ?", token.Hex(), holder.Hex(), holder.Hex(), from, to).Order("blocks.number asc, token_transfers.index asc, token_transfers.batchindex asc").Select("token_transfers.token, token_transfers.standard, token_transfers.from, token_transfers.to, token_transfers.tokenid, token_transfers.amount, token_transfers.index, token_transfers.batchindex, token_transfers.txhash, token_transfers.blockhash").Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+
+}
+
+// GetTokenTransfersOfHolderForTokenByBlockTimeRange - Given token contract address, token holder address & block time range, returns
+// all transfers of this token, either sent from or received by this address, in that time span
+func GetTokenTransfersOfHolderForTokenByBlockTimeRange(db *gorm.DB, token common.Address, holder common.Address, from uint64, to uint64) *data.TokenTransfers {
+
+	var transfers []*data.TokenTransfer
+
+	if err := db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("token_transfers.token = ? and (token_transfers.from = ? or token_transfers.to = ?) and blocks.time >= ? and blocks.time <= ?", token.Hex(), holder.Hex(), holder.Hex(), from, to).Order("blocks.number asc, token_transfers.index asc, token_transfers.batchindex asc").Select("token_transfers.token, token_transfers.standard, token_transfers.from, token_transfers.to, token_transfers.tokenid, token_transfers.amount, token_transfers.index, token_transfers.batchindex, token_transfers.txhash, token_transfers.blockhash").Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+
+}
+
+// GetInternalTransactionsByTransactionHash - Given tx hash, returns all internal tx(s)
+// i.e. calls made by contracts, during execution of that tx, in depth first order
+func GetInternalTransactionsByTransactionHash(db *gorm.DB, hash common.Hash) *data.InternalTransactions {
+
+	var txs []*data.InternalTransaction
+
+	if err := db.Model(&InternalTransactions{}).Where("txhash = ?", hash.Hex()).Order("index asc").Find(&txs).Error; err != nil {
+		return nil
+	}
+
+	return &data.InternalTransactions{
+		InternalTransactions: txs,
+	}
+
+}
+
+// GetInternalTransactionsByBlockHash - Given block hash, returns all internal tx(s)
+// made during execution of tx(s) present in that block
+func GetInternalTransactionsByBlockHash(db *gorm.DB, hash common.Hash) *data.InternalTransactions {
+
+	var txs []*data.InternalTransaction
+
+	if err := db.Model(&InternalTransactions{}).Where("blockhash = ?", hash.Hex()).Order("txhash asc, index asc").Find(&txs).Error; err != nil {
+		return nil
+	}
+
+	return &data.InternalTransactions{
+		InternalTransactions: txs,
+	}
+
+}
+
+// GetInternalTransactionsOfAccountByBlockNumberRange - Given account address & block number range, returns
+// all internal tx(s) either sent from or received by this address, including contracts created by it
+func GetInternalTransactionsOfAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.InternalTransactions {
+
+	var txs []*data.InternalTransaction
+
+	if err := db.Model(&InternalTransactions{}).Joins("left join blocks on internal_transactions.blockhash = blocks.hash").Where("(internal_transactions.from = ? or internal_transactions.to = ? or internal_transactions.contract = ?) and blocks.number >= ? and blocks.number <= ?", account.Hex(), account.Hex(), account.Hex(), from, to).Order("blocks.number asc, internal_transactions.txhash asc, internal_transactions.index asc").Select("internal_transactions.txhash, internal_transactions.index, internal_transactions.depth, internal_transactions.type, internal_transactions.from, internal_transactions.to, internal_transactions.contract, internal_transactions.value, internal_transactions.gas, internal_transactions.gasused, internal_transactions.error, internal_transactions.blockhash").Find(&txs).Error; err != nil {
+		return nil
+	}
+
+	return &data.InternalTransactions{
+		InternalTransactions: txs,
+	}
+
+}
+
+// GetInternalTransactionsOfAccountByBlockTimeRange - Given account address & block time range, returns
+// all internal tx(s) either sent from or received by this address, including contracts created by it
+func GetInternalTransactionsOfAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.InternalTransactions {
+
+	var txs []*data.InternalTransaction
+
+	if err := db.Model(&InternalTransactions{}).Joins("left join blocks on internal_transactions.blockhash = blocks.hash").Where("(internal_transactions.from = ? or internal_transactions.to = ? or internal_transactions.contract = ?) and blocks.time >= ? and blocks.time <= ?", account.Hex(), account.Hex(), account.Hex(), from, to).Order("blocks.number asc, internal_transactions.txhash asc, internal_transactions.index asc").Select("internal_transactions.txhash, internal_transactions.index, internal_transactions.depth, internal_transactions.type, internal_transactions.from, internal_transactions.to, internal_transactions.contract, internal_transactions.value, internal_transactions.gas, internal_transactions.gasused, internal_transactions.error, internal_transactions.blockhash").Find(&txs).Error; err != nil {
+		return nil
+	}
+
+	return &data.InternalTransactions{
+		InternalTransactions: txs,
+	}
+
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"time"
+
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QueueStore - Keeps block processor queue state in database, so that
+// it survives restarts
+type QueueStore struct {
+	DB *gorm.DB
+}
+
+// LoadQueue - Reads all blocks which were present in block processor
+// queue, last time its state was saved
+func (s *QueueStore) LoadQueue() (map[uint64]*q.Block, error) {
+
+	var queued []*QueuedBlocks
+
+	if err := s.DB.Model(&QueuedBlocks{}).Find(&queued).Error; err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[uint64]*q.Block, len(queued))
+	for _, v := range queued {
+
+		blocks[v.Number] = &q.Block{
+			UnconfirmedProgress: v.UnconfirmedProgress,
+			Published:           v.Published,
+			UnconfirmedDone:     v.UnconfirmedDone,
+			ConfirmedProgress:   v.ConfirmedProgress,
+			ConfirmedDone:       v.ConfirmedDone,
+			LastAttempted:       v.LastAttempted,
+			Delay:               time.Duration(v.Delay),
+		}
+
+	}
+
+	return blocks, nil
+
+}
+
+// SaveQueue - Upserts state of updated blocks & deletes removed ones,
+// inside a single database transaction
+func (s *QueueStore) SaveQueue(updated map[uint64]*q.Block, deleted []uint64) error {
+
+	return s.DB.Transaction(func(dbWTx *gorm.DB) error {
+
+		if len(deleted) != 0 {
+
+			if err := dbWTx.Where("number in ?", deleted).Delete(&QueuedBlocks{}).Error; err != nil {
+				return err
+			}
+
+		}
+
+		if len(updated) == 0 {
+			return nil
+		}
+
+		queued := make([]*QueuedBlocks, 0, len(updated))
+		for k, v := range updated {
+
+			queued = append(queued, &QueuedBlocks{
+				Number:              k,
+				UnconfirmedProgress: v.UnconfirmedProgress,
+				Published:           v.Published,
+				UnconfirmedDone:     v.UnconfirmedDone,
+				ConfirmedProgress:   v.ConfirmedProgress,
+				ConfirmedDone:       v.ConfirmedDone,
+				LastAttempted:       v.LastAttempted,
+				Delay:               int64(v.Delay),
+			})
+
+		}
+
+		return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(queued, 1000).Error
+
+	})
+
+}
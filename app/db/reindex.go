@@ -0,0 +1,420 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	d "github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+)
+
+// diffTransactions - Compares tx(s) persisted in database with freshly fetched
+// ones, returning which ones are to be upserted & which ones are to be deleted
+func diffTransactions(persisted []*Transactions, fetched []*PackedTransaction, diff *d.RowDiff) ([]*Transactions, []*Transactions) {
+
+	known := make(map[string]*Transactions, len(persisted))
+	for _, v := range persisted {
+		known[v.Hash] = v
+	}
+
+	upsert := make([]*Transactions, 0)
+
+	for _, v := range fetched {
+
+		_v, ok := known[v.Tx.Hash]
+		delete(known, v.Tx.Hash)
+
+		if !ok {
+			diff.Missing++
+			upsert = append(upsert, v.Tx)
+			continue
+		}
+
+		if !_v.SimilarTo(v.Tx) {
+			diff.Mismatched++
+			upsert = append(upsert, v.Tx)
+		}
+
+	}
+
+	remove := make([]*Transactions, 0, len(known))
+	for _, v := range known {
+		diff.Extra++
+		remove = append(remove, v)
+	}
+
+	return upsert, remove
+
+}
+
+// diffEvents - Compares event logs persisted in database with freshly fetched
+// ones, returning which ones are to be upserted & which ones are to be deleted
+func diffEvents(persisted []*Events, fetched []*PackedTransaction, diff *d.RowDiff) ([]*Events, []*Events) {
+
+	known := make(map[string]*Events, len(persisted))
+	for _, v := range persisted {
+		known[fmt.Sprintf("%s/%d", v.BlockHash, v.Index)] = v
+	}
+
+	upsert := make([]*Events, 0)
+
+	for _, t := range fetched {
+
+		for _, v := range t.Events {
+
+			key := fmt.Sprintf("%s/%d", v.BlockHash, v.Index)
+
+			_v, ok := known[key]
+			delete(known, key)
+
+			if !ok {
+				diff.Missing++
+				upsert = append(upsert, v)
+				continue
+			}
+
+			if !_v.SimilarTo(v) {
+				diff.Mismatched++
+				upsert = append(upsert, v)
+			}
+
+		}
+
+	}
+
+	remove := make([]*Events, 0, len(known))
+	for _, v := range known {
+		diff.Extra++
+		remove = append(remove, v)
+	}
+
+	return upsert, remove
+
+}
+
+// diffTokenTransfers - Compares token transfers persisted in database with freshly
+// fetched ones, returning which ones are to be upserted & which ones are to be deleted
+func diffTokenTransfers(persisted []*TokenTransfers, fetched []*PackedTransaction, diff *d.RowDiff) ([]*TokenTransfers, []*TokenTransfers) {
+
+	known := make(map[string]*TokenTransfers, len(persisted))
+	for _, v := range persisted {
+		known[fmt.Sprintf("%s/%d/%d", v.BlockHash, v.Index, v.BatchIndex)] = v
+	}
+
+	upsert := make([]*TokenTransfers, 0)
+
+	for _, t := range fetched {
+
+		for _, v := range t.TokenTransfers {
+
+			key := fmt.Sprintf("%s/%d/%d", v.BlockHash, v.Index, v.BatchIndex)
+
+			_v, ok := known[key]
+			delete(known, key)
+
+			if !ok {
+				diff.Missing++
+				upsert = append(upsert, v)
+				continue
+			}
+
+			if !_v.SimilarTo(v) {
+				diff.Mismatched++
+				upsert = append(upsert, v)
+			}
+
+		}
+
+	}
+
+	remove := make([]*TokenTransfers, 0, len(known))
+	for _, v := range known {
+		diff.Extra++
+		remove = append(remove, v)
+	}
+
+	return upsert, remove
+
+}
+
+// diffInternalTransactions - Compares internal tx(s) persisted in database with freshly
+// fetched ones, returning which ones are to be upserted & which ones are to be deleted
+func diffInternalTransactions(persisted []*InternalTransactions, fetched []*PackedTransaction, diff *d.RowDiff) ([]*InternalTransactions, []*InternalTransactions) {
+
+	known := make(map[string]*InternalTransactions, len(persisted))
+	for _, v := range persisted {
+		known[fmt.Sprintf("%s/%d", v.TransactionHash, v.Index)] = v
+	}
+
+	upsert := make([]*InternalTransactions, 0)
+
+	for _, t := range fetched {
+
+		for _, v := range t.InternalTransactions {
+
+			key := fmt.Sprintf("%s/%d", v.TransactionHash, v.Index)
+
+			_v, ok := known[key]
+			delete(known, key)
+
+			if !ok {
+				diff.Missing++
+				upsert = append(upsert, v)
+				continue
+			}
+
+			if !_v.SimilarTo(v) {
+				diff.Mismatched++
+				upsert = append(upsert, v)
+			}
+
+		}
+
+	}
+
+	remove := make([]*InternalTransactions, 0, len(known))
+	for _, v := range known {
+		diff.Extra++
+		remove = append(remove, v)
+	}
+
+	return upsert, remove
+
+}
+
+// ReindexBlock - Compares freshly fetched block with what's persisted in database,
+// i.e. block header, tx(s), event logs, token transfers & internal tx(s), fixing all
+// mismatches found, inside single database transaction, returning what was changed
+//
+// Internal tx(s) are compared only when block was traced, otherwise they're left as it is,
+// while when block is replaced, those of tx(s) still present in block are kept
+func ReindexBlock(dbWOTx *gorm.DB, block *PackedBlock, traced bool, status *d.StatusHolder) (*d.ReindexReport, error) {
+
+	if block == nil {
+		return nil, errors.New("empty block received while attempting to re-index")
+	}
+
+	if err := EnsurePartition(dbWOTx, block.Block.Number); err != nil {
+		return nil, err
+	}
+
+	report := &d.ReindexReport{Number: block.Block.Number}
+
+	err := dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
+
+		var (
+			txs       []*Transactions
+			events    []*Events
+			transfers []*TokenTransfers
+			internals []*InternalTransactions
+		)
+
+		persistedBlock := GetBlock(dbWTx, block.Block.Number)
+		if persistedBlock != nil {
+
+			if err := dbWTx.Where("blockhash = ?", persistedBlock.Hash).Find(&txs).Error; err != nil {
+				return err
+			}
+
+			if err := dbWTx.Where("blockhash = ?", persistedBlock.Hash).Find(&events).Error; err != nil {
+				return err
+			}
+
+			if err := dbWTx.Where("blockhash = ?", persistedBlock.Hash).Find(&transfers).Error; err != nil {
+				return err
+			}
+
+			// Internal tx(s) are also required when block is to be replaced,
+			// for keeping them, if block wasn't traced
+			if traced || persistedBlock.Hash != block.Block.Hash {
+
+				if err := dbWTx.Where("blockhash = ?", persistedBlock.Hash).Find(&internals).Error; err != nil {
+					return err
+				}
+
+			}
+
+		}
+
+		upsertTxs, removeTxs := diffTransactions(txs, block.Transactions, &report.Transactions)
+		upsertEvents, removeEvents := diffEvents(events, block.Transactions, &report.Events)
+		upsertTransfers, removeTransfers := diffTokenTransfers(transfers, block.Transactions, &report.TokenTransfers)
+
+		var upsertInternals, removeInternals []*InternalTransactions
+		if traced {
+			upsertInternals, removeInternals = diffInternalTransactions(internals, block.Transactions, &report.InternalTransactions)
+		}
+
+		switch {
+
+		case persistedBlock == nil:
+
+			report.Status = d.ReindexInserted
+			report.Header = true
+			report.NewHash = block.Block.Hash
+
+			if err := PutBlock(dbWTx, block.Block); err != nil {
+				return err
+			}
+
+		case persistedBlock.Hash != block.Block.Hash:
+
+			report.Status = d.ReindexReplaced
+			report.Header = true
+			report.OldHash = persistedBlock.Hash
+			report.NewHash = block.Block.Hash
+
+			// cascaded deletion, so nothing else to be removed !
+			if err := DeleteBlock(dbWTx, block.Block.Number); err != nil {
+				return err
+			}
+
+			var ancestor uint64
+			if block.Block.Number > 0 {
+				ancestor = block.Block.Number - 1
+			}
+
+			if err := PutReorg(dbWTx, &Reorgs{
+				Ancestor:  ancestor,
+				Depth:     1,
+				OldHead:   persistedBlock.Hash,
+				NewHead:   block.Block.Hash,
+				OldHashes: []string{persistedBlock.Hash},
+				NewHashes: []string{block.Block.Hash},
+			}); err != nil {
+				return err
+			}
+
+			if err := PutBlock(dbWTx, block.Block); err != nil {
+				return err
+			}
+
+			// Block wasn't traced, so internal tx(s) of those tx(s), which are
+			// still present in block, are kept, rather than losing them with
+			// cascaded deletion
+			if !traced {
+
+				present := make(map[string]bool, len(block.Transactions))
+				for _, v := range block.Transactions {
+					present[v.Tx.Hash] = true
+				}
+
+				for _, v := range internals {
+
+					if !present[v.TransactionHash] {
+						continue
+					}
+
+					v.BlockHash = block.Block.Hash
+					upsertInternals = append(upsertInternals, v)
+
+				}
+
+			}
+
+		default:
+
+			report.Header = !persistedBlock.SimilarTo(block.Block)
+
+			if !report.Changed() {
+				report.Status = d.ReindexUnchanged
+				return nil
+			}
+
+			report.Status = d.ReindexUpdated
+
+			if report.Header {
+
+				if err := UpdateBlock(dbWTx, block.Block); err != nil {
+					return err
+				}
+
+			}
+
+			// Removing tx cascades all rows dependent on it, except when tables
+			// are partitioned, so internal tx(s), which are not compared unless
+			// block was traced, are removed explicitly
+			for _, v := range removeTxs {
+
+				if err := dbWTx.Where("txhash = ?", v.Hash).Delete(&InternalTransactions{}).Error; err != nil {
+					return err
+				}
+
+				if err := dbWTx.Where("hash = ?", v.Hash).Delete(&Transactions{}).Error; err != nil {
+					return err
+				}
+
+			}
+
+			for _, v := range removeEvents {
+
+				if err := dbWTx.Where("blockhash = ? and index = ?", v.BlockHash, v.Index).Delete(&Events{}).Error; err != nil {
+					return err
+				}
+
+			}
+
+			for _, v := range removeTransfers {
+
+				if err := dbWTx.Where("blockhash = ? and index = ? and batchindex = ?", v.BlockHash, v.Index, v.BatchIndex).Delete(&TokenTransfers{}).Error; err != nil {
+					return err
+				}
+
+			}
+
+			for _, v := range removeInternals {
+
+				if err := dbWTx.Where("txhash = ? and index = ?", v.TransactionHash, v.Index).Delete(&InternalTransactions{}).Error; err != nil {
+					return err
+				}
+
+			}
+
+		}
+
+		for _, v := range upsertTxs {
+
+			if err := UpsertTransaction(dbWTx, v); err != nil {
+				return err
+			}
+
+		}
+
+		for _, v := range upsertEvents {
+
+			if err := UpsertEvent(dbWTx, v); err != nil {
+				return err
+			}
+
+		}
+
+		for _, v := range upsertTransfers {
+
+			if err := UpsertTokenTransfer(dbWTx, v); err != nil {
+				return err
+			}
+
+		}
+
+		for _, v := range upsertInternals {
+
+			if err := UpsertInternalTransaction(dbWTx, v); err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if report.Status == d.ReindexInserted && status != nil {
+		status.IncrementBlocksInserted()
+	}
+
+	return report, nil
+
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GetBlocksAboveNumber - Returns all blocks persisted in database, with
+// number strictly greater than given one, in ascending order of block number
+func GetBlocksAboveNumber(_db *gorm.DB, number uint64) []*Blocks {
+	var blocks []*Blocks
+
+	if err := _db.Model(&Blocks{}).Where("number > ?", number).Order("number asc").Find(&blocks).Error; err != nil {
+		return nil
+	}
+
+	return blocks
+}
+
+// PutReorg - Persisting chain reorganization related info
+func PutReorg(dbWTx *gorm.DB, reorg *Reorgs) error {
+
+	if reorg.TimeStamp.IsZero() {
+		reorg.TimeStamp = time.Now().UTC()
+	}
+
+	return dbWTx.Create(reorg).Error
+
+}
+
+// RollbackToAncestor - Deletes all blocks above common ancestor ( cascading
+// all dependent entries in transactions/ events table ) & records this reorg,
+// inside a single database transaction, so either whole orphaned
+// branch gets removed or nothing does
+func RollbackToAncestor(dbWOTx *gorm.DB, reorg *Reorgs) error {
+
+	return dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := deleteBlocks(dbWTx, "number > ?", reorg.Ancestor); err != nil {
+			return err
+		}
+
+		return PutReorg(dbWTx, reorg)
+
+	})
+
+}
@@ -0,0 +1,287 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/metrics"
+	"gorm.io/gorm"
+)
+
+// replicasPluginName - Name with which replica pool is registered
+// as plugin, on handle to primary database
+const replicasPluginName = "ette:replicas"
+
+// Replica - Read replica of database, along with its state,
+// as of last health check
+type Replica struct {
+	Name    string
+	DB      *sql.DB
+	Healthy bool
+	Head    uint64
+	Lag     uint64
+	Checked time.Time
+}
+
+// ReplicaStat - State of read replica, as of last health check,
+// to be reported to client
+type ReplicaStat struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Head    uint64 `json:"head"`
+	Lag     uint64 `json:"lag"`
+	Checked string `json:"checked"`
+}
+
+// ReplicaPool - Read replicas, to which read only queries are routed, in round
+// robin fashion, skipping those which are not healthy, while primary is used
+// when none of them are
+type ReplicaPool struct {
+	Primary  gorm.ConnPool
+	Replicas []*Replica
+	Lock     *sync.RWMutex
+
+	next   int
+	reader *gorm.DB
+}
+
+// Name - Name of plugin, as required by gorm
+func (r *ReplicaPool) Name() string {
+	return replicasPluginName
+}
+
+// Initialize - Prepares handle, using which read only queries are
+// to be routed to replicas, as required by gorm
+func (r *ReplicaPool) Initialize(_db *gorm.DB) error {
+
+	r.Primary = _db.Statement.ConnPool
+
+	// Statement is cloned, because it's shared with handle to
+	// primary otherwise
+	reader := _db.Session(&gorm.Session{NewDB: true, Context: context.Background()})
+	reader.Config.ConnPool = r
+	reader.Statement.ConnPool = r
+
+	r.reader = reader
+	return nil
+
+}
+
+// pick - Picks next healthy replica, in round robin fashion, returning
+// primary when none of them are healthy
+func (r *ReplicaPool) pick() gorm.ConnPool {
+
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	for i := 0; i < len(r.Replicas); i++ {
+
+		v := r.Replicas[r.next]
+		r.next = (r.next + 1) % len(r.Replicas)
+
+		if v.Healthy {
+			return v.DB
+		}
+
+	}
+
+	return r.Primary
+
+}
+
+// PrepareContext - Prepares statement on picked connection pool
+func (r *ReplicaPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return r.pick().PrepareContext(ctx, query)
+}
+
+// ExecContext - Executes query on picked connection pool
+func (r *ReplicaPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return r.pick().ExecContext(ctx, query, args...)
+}
+
+// QueryContext - Runs query on picked connection pool
+func (r *ReplicaPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return r.pick().QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext - Runs query, returning single row, on picked connection pool
+func (r *ReplicaPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return r.pick().QueryRowContext(ctx, query, args...)
+}
+
+// Stat - State of all replicas, as of last health check
+func (r *ReplicaPool) Stat() []*ReplicaStat {
+
+	r.Lock.RLock()
+	defer r.Lock.RUnlock()
+
+	stat := make([]*ReplicaStat, len(r.Replicas))
+
+	for k, v := range r.Replicas {
+
+		stat[k] = &ReplicaStat{
+			Name:    v.Name,
+			Healthy: v.Healthy,
+			Head:    v.Head,
+			Lag:     v.Lag,
+			Checked: v.Checked.Format(time.RFC3339),
+		}
+
+	}
+
+	return stat
+
+}
+
+// check - Finds out how far each replica is behind primary, in terms of
+// latest block persisted, marking those which are too far behind or
+// can't be reached, as not healthy
+func (r *ReplicaPool) check(_db *gorm.DB) {
+
+	head := GetCurrentBlockNumber(_db)
+
+	for _, v := range r.Replicas {
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+
+		var _head sql.NullInt64
+		err := v.DB.QueryRowContext(ctx, "select max(number) from blocks").Scan(&_head)
+
+		cancel()
+
+		var lag uint64
+		if err == nil && uint64(_head.Int64) < head {
+			lag = head - uint64(_head.Int64)
+		}
+
+		healthy := err == nil && lag <= cfg.GetMaxReplicaLag()
+		if err != nil {
+			log.Printf("[!] Failed to check replica %s : %s\n", v.Name, err.Error())
+		} else if !healthy {
+			log.Printf("[!] Replica %s is %d block(s) behind primary\n", v.Name, lag)
+		}
+
+		r.Lock.Lock()
+
+		v.Healthy = healthy
+		v.Head = uint64(_head.Int64)
+		v.Lag = lag
+		v.Checked = time.Now().UTC()
+
+		r.Lock.Unlock()
+
+		metrics.SetReplicaLag(v.Name, lag, healthy)
+
+	}
+
+}
+
+// replicaName - Host & port of replica, so that credentials present
+// in connection string aren't exposed
+func replicaName(dsn string, index int) string {
+
+	if u, err := url.Parse(dsn); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return fmt.Sprintf("replica-%d", index)
+
+}
+
+// connectReplicas - Opens connection pools to all read replicas, registering them
+// as plugin on handle to primary, so that they can be looked up using `Reader`
+//
+// Replicas are considered not healthy until first health check is performed
+func connectReplicas(_db *gorm.DB, dsns []string) {
+
+	pool := &ReplicaPool{
+		Replicas: make([]*Replica, 0, len(dsns)),
+		Lock:     &sync.RWMutex{},
+	}
+
+	for k, v := range dsns {
+
+		_sql, err := sql.Open("pgx", v)
+		if err != nil {
+			log.Printf("[!] Failed to connect to replica %s : %s\n", replicaName(v, k), err.Error())
+			continue
+		}
+
+		pool.Replicas = append(pool.Replicas, &Replica{Name: replicaName(v, k), DB: _sql})
+
+	}
+
+	if len(pool.Replicas) == 0 {
+		return
+	}
+
+	if err := _db.Use(pool); err != nil {
+		log.Printf("[!] Failed to register read replicas : %s\n", err.Error())
+	}
+
+}
+
+// Replicas - Returns pool of read replicas registered on handle to
+// primary, if any
+func Replicas(_db *gorm.DB) *ReplicaPool {
+
+	if v, ok := _db.Config.Plugins[replicasPluginName]; ok {
+		if pool, ok := v.(*ReplicaPool); ok {
+			return pool
+		}
+	}
+
+	return nil
+
+}
+
+// Reader - Returns handle to be used for read only queries, which are routed
+// to healthy read replicas, if any, otherwise it's handle to primary itself
+//
+// Writes & reads which must see what was just written, are never to be
+// performed using it
+func Reader(_db *gorm.DB) *gorm.DB {
+
+	if pool := Replicas(_db); pool != nil {
+		return pool.reader
+	}
+
+	return _db
+
+}
+
+// CheckReplicas - Periodically checks health of all read replicas, until
+// asked to stop, which does nothing when there's none
+func CheckReplicas(ctx context.Context, _db *gorm.DB) {
+
+	pool := Replicas(_db)
+	if pool == nil {
+		return
+	}
+
+	pool.check(_db)
+
+	ticker := time.NewTicker(time.Duration(cfg.GetHealthCheckInterval()) * time.Second)
+	defer ticker.Stop()
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			pool.check(_db)
+
+		}
+
+	}
+
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	d "github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+)
+
+// PruneBlocks - Removes all blocks in [from, to] range along with all of their
+// rows, inside single database transaction, returning how many rows got removed
+// from each table
+//
+// Rows referring to blocks are removed before blocks, even though they'd be
+// removed anyway, due to cascading, so that they can be counted
+func PruneBlocks(_db *gorm.DB, from uint64, to uint64) (*d.PruneReport, error) {
+
+	report := &d.PruneReport{}
+
+	err := _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		hashes := dbWTx.Model(&Blocks{}).Select("hash").Where("number >= ? and number <= ?", from, to)
+
+		for _, v := range []struct {
+			model interface{}
+			count *int64
+		}{
+			{&InternalTransactions{}, &report.InternalTransactions},
+			{&TokenTransfers{}, &report.TokenTransfers},
+			{&Events{}, &report.Events},
+			{&Transactions{}, &report.Transactions},
+		} {
+
+			res := dbWTx.Where("blockhash in (?)", hashes).Delete(v.model)
+			if res.Error != nil {
+				return res.Error
+			}
+
+			*v.count = res.RowsAffected
+
+		}
+
+		res := dbWTx.Where("number >= ? and number <= ?", from, to).Delete(&Blocks{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		report.Blocks = res.RowsAffected
+		return nil
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+
+}
+
+// PruneEvents - Removes event logs of blocks in [from, to] range, along with token
+// transfers derived from them, which are not emitted by any of given contracts,
+// inside single database transaction
+func PruneEvents(_db *gorm.DB, from uint64, to uint64, contracts []string) (*d.PruneReport, error) {
+
+	report := &d.PruneReport{}
+
+	err := _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		hashes := dbWTx.Model(&Blocks{}).Select("hash").Where("number >= ? and number <= ?", from, to)
+
+		res := dbWTx.Where("blockhash in (?) and token not in ?", hashes, contracts).Delete(&TokenTransfers{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		report.TokenTransfers = res.RowsAffected
+
+		res = dbWTx.Where("blockhash in (?) and origin not in ?", hashes, contracts).Delete(&Events{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		report.Events = res.RowsAffected
+		return nil
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+
+}
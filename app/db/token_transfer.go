@@ -0,0 +1,20 @@
+package db
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertTokenTransfer - Token transfer decoded from event log, to be persisted,
+// while updating all fields if it was already persisted, due to chain reorganization
+func UpsertTokenTransfer(dbWTx *gorm.DB, transfer *TokenTransfers) error {
+
+	if transfer == nil {
+		return errors.New("empty token transfer received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(transfer).Error
+
+}
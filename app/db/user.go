@@ -121,6 +121,9 @@ func ValidateAPIKey(_db *gorm.DB, apiKey string) bool {
 // to client application on this day ( identified using signer address
 // i.e. who created API Key ), for query response or for real-time data delivery,
 // is under a limit ( currently hardcoded inside code ) or not
+//
+// Delivery history is just written by primary, so it must be counted
+// on primary, replicas can be behind on it
 func IsUnderRateLimit(_db *gorm.DB, userAddress string) bool {
 
 	// Getting current local time of machine
@@ -151,15 +154,30 @@ func IsUnderRateLimit(_db *gorm.DB, userAddress string) bool {
 // Before delivering any piece of data to client, rate limit to be checked for last 24 hours
 // not older than that
 //
-// @note This function can be invoked every 24 hours to clean up historical entries
-func DropOldDeliveryHistories(_db *gorm.DB) {
+// Entries are deleted in batches of given size, so that table doesn't stay locked
+// for long, returning how many of them got deleted
+//
+// Delivery timestamps are kept in UTC, so cut off is also computed in UTC, rather
+// than using `now()`, which follows time zone of database session
+func DropOldDeliveryHistories(_db *gorm.DB, batch int) (int64, error) {
 
-	// Wrapping delete operation inside DB transaction to make it
-	// consistent to other parties attempting to read from same table
-	_db.Transaction(func(dbWtx *gorm.DB) error {
+	cutOff := time.Now().UTC().Add(-time.Duration(24) * time.Hour)
 
-		return dbWtx.Where("delivery_history.ts < now() - interval '24 hours'").Delete(&DeliveryHistory{}).Error
+	var deleted int64
 
-	})
+	for {
+
+		res := _db.Where("id in (?)", _db.Model(&DeliveryHistory{}).Select("id").Where("ts < ?", cutOff).Limit(batch)).Delete(&DeliveryHistory{})
+		if res.Error != nil {
+			return deleted, res.Error
+		}
+
+		deleted += res.RowsAffected
+
+		if res.RowsAffected < int64(batch) {
+			return deleted, nil
+		}
+
+	}
 
 }
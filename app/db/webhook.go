@@ -0,0 +1,173 @@
+package db
+
+import (
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+// RegisterWebhook - Registers webhook for app identified by API key, returning
+// it, when persisted successfully
+func RegisterWebhook(_db *gorm.DB, apiKey string, topic string, url string, secret string) *Webhooks {
+
+	webhook := &Webhooks{
+		APIKey:    apiKey,
+		Topic:     topic,
+		URL:       url,
+		Secret:    secret,
+		TimeStamp: time.Now().UTC(),
+	}
+
+	if err := _db.Create(webhook).Error; err != nil {
+		log.Printf("[!] Failed to register webhook : %s\n", err.Error())
+		return nil
+	}
+
+	return webhook
+
+}
+
+// GetWebhooksByAPIKey - Returns all webhooks registered for app identified by
+// API key, latest one first
+func GetWebhooksByAPIKey(_db *gorm.DB, apiKey string) []*Webhooks {
+	var webhooks []*Webhooks
+
+	if err := _db.Model(&Webhooks{}).Where("webhooks.apikey = ?", apiKey).Order("webhooks.ts desc").Find(&webhooks).Error; err != nil {
+		return nil
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	return webhooks
+}
+
+// GetWebhooksByUserAddress - Returns all webhooks registered for any of apps
+// created by user, latest one first
+func GetWebhooksByUserAddress(_db *gorm.DB, address common.Address) []*Webhooks {
+	var webhooks []*Webhooks
+
+	if err := _db.Model(&Webhooks{}).
+		Joins("join users on webhooks.apikey = users.apikey").
+		Where("users.address = ?", address.Hex()).
+		Order("webhooks.ts desc").
+		Find(&webhooks).Error; err != nil {
+		return nil
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	return webhooks
+}
+
+// GetAllWebhooks - Returns all registered webhooks, to which real-time data
+// is to be delivered
+func GetAllWebhooks(_db *gorm.DB) ([]*Webhooks, error) {
+	var webhooks []*Webhooks
+
+	if err := _db.Model(&Webhooks{}).Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// IsWebhookOwnedBy - Checks whether webhook is registered for any of apps
+// created by user
+func IsWebhookOwnedBy(_db *gorm.DB, id string, address common.Address) bool {
+	var count int64
+
+	if err := _db.Model(&Webhooks{}).
+		Joins("join users on webhooks.apikey = users.apikey").
+		Where("webhooks.id = ? and users.address = ?", id, address.Hex()).
+		Count(&count).Error; err != nil {
+		return false
+	}
+
+	return count == 1
+}
+
+// DeleteWebhook - Deletes webhook, if it's registered for any of apps created
+// by user, along with its dead letters
+func DeleteWebhook(_db *gorm.DB, id string, address common.Address) bool {
+
+	if !IsWebhookOwnedBy(_db, id, address) {
+		return false
+	}
+
+	if err := _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := dbWTx.Where("webhook = ?", id).Delete(&WebhookDeadLetters{}).Error; err != nil {
+			return err
+		}
+
+		return dbWTx.Where("id = ?", id).Delete(&Webhooks{}).Error
+
+	}); err != nil {
+		log.Printf("[!] Failed to delete webhook : %s\n", err.Error())
+		return false
+	}
+
+	return true
+
+}
+
+// PutWebhookDeadLetter - Persists data, which couldn't be delivered to
+// webhook, after attempting given number of times
+func PutWebhookDeadLetter(_db *gorm.DB, webhook string, payload string, attempts uint64, reason string) {
+	if err := _db.Create(&WebhookDeadLetters{
+		Webhook:   webhook,
+		Payload:   payload,
+		Attempts:  attempts,
+		Error:     reason,
+		TimeStamp: time.Now().UTC(),
+	}).Error; err != nil {
+		log.Printf("[!] Failed to persist webhook dead letter : %s\n", err.Error())
+	}
+}
+
+// GetWebhookDeadLetters - Returns latest dead letters of webhook, at max
+// given many of them, latest one first
+func GetWebhookDeadLetters(_db *gorm.DB, webhook string, limit int) []*WebhookDeadLetters {
+	var letters []*WebhookDeadLetters
+
+	if err := _db.Model(&WebhookDeadLetters{}).Where("webhook_dead_letters.webhook = ?", webhook).Order("webhook_dead_letters.ts desc").Limit(limit).Find(&letters).Error; err != nil {
+		return nil
+	}
+
+	if letters == nil {
+		return make([]*WebhookDeadLetters, 0)
+	}
+
+	return letters
+}
+
+// DropOldWebhookDeadLetters - Attempts to delete dead letters older than 7 days,
+// in batches of given size, returning how many of them got deleted
+func DropOldWebhookDeadLetters(_db *gorm.DB, batch int) (int64, error) {
+
+	cutOff := time.Now().UTC().Add(-time.Duration(7*24) * time.Hour)
+
+	var deleted int64
+
+	for {
+
+		res := _db.Where("id in (?)", _db.Model(&WebhookDeadLetters{}).Select("id").Where("ts < ?", cutOff).Limit(batch)).Delete(&WebhookDeadLetters{})
+		if res.Error != nil {
+			return deleted, res.Error
+		}
+
+		deleted += res.RowsAffected
+
+		if res.RowsAffected < int64(batch) {
+			return deleted, nil
+		}
+
+	}
+
+}
@@ -0,0 +1,118 @@
+package decoder
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/itzmeanjan/ette/app/db"
+	"gorm.io/gorm"
+)
+
+const (
+	// ttl - For how long parsed ABI ( or absence of it ) is to be
+	// remembered, before looking up database again
+	ttl = time.Minute
+	// capacity - Max #-of contracts to be kept in cache, when it's hit
+	// expired entries are evicted, if that's not enough, cache is cleared
+	capacity = 10000
+)
+
+// entry - Parsed ABI of contract, where nil denotes ABI isn't known
+type entry struct {
+	abi       *abi.ABI
+	expiresAt time.Time
+}
+
+// cache - Parsed contract ABIs, kept in memory, so that database
+// isn't looked up for each event log/ tx being decoded
+type cache struct {
+	entries map[common.Address]*entry
+	lock    sync.RWMutex
+}
+
+var abis = &cache{entries: make(map[common.Address]*entry)}
+
+// get - Returns parsed ABI of contract & whether cached entry
+// is still fresh enough to be used
+func (c *cache) get(address common.Address) (*abi.ABI, bool) {
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	v, ok := c.entries[address]
+	if !ok || time.Now().After(v.expiresAt) {
+		return nil, false
+	}
+
+	return v.abi, true
+
+}
+
+// put - Remembers parsed ABI of contract, for `ttl` duration
+func (c *cache) put(address common.Address, parsed *abi.ABI) {
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.entries) >= capacity {
+
+		now := time.Now()
+
+		for k, v := range c.entries {
+			if now.After(v.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+
+		if len(c.entries) >= capacity {
+			c.entries = make(map[common.Address]*entry)
+		}
+
+	}
+
+	c.entries[address] = &entry{abi: parsed, expiresAt: time.Now().Add(ttl)}
+
+}
+
+// forget - Invalidates cached ABI of contract, to be invoked
+// when new ABI is uploaded
+func (c *cache) forget(address common.Address) {
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, address)
+
+}
+
+// lookup - Returns parsed ABI of contract, if known, first
+// checking in cache, then in database
+func lookup(_db *gorm.DB, address string) *abi.ABI {
+
+	if !common.IsHexAddress(address) {
+		return nil
+	}
+
+	_address := common.HexToAddress(address)
+
+	if parsed, ok := abis.get(_address); ok {
+		return parsed
+	}
+
+	var parsed *abi.ABI
+
+	if stored := db.GetABI(_db, _address); stored != nil {
+
+		if _parsed, err := abi.JSON(strings.NewReader(stored.ABI)); err == nil {
+			parsed = &_parsed
+		}
+
+	}
+
+	abis.put(_address, parsed)
+	return parsed
+
+}
@@ -0,0 +1,217 @@
+package decoder
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"gorm.io/gorm"
+)
+
+// Register - Validates & persists ABI of contract, so that event logs
+// emitted by it & tx(s) sent to it, can be decoded
+func Register(_db *gorm.DB, address common.Address, abiJSON string, uploader common.Address) error {
+
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return err
+	}
+
+	if len(parsed.Events) == 0 && len(parsed.Methods) == 0 {
+		return errors.New("no event/ method found in ABI")
+	}
+
+	if err := db.PutABI(_db, address, abiJSON, uploader); err != nil {
+		return err
+	}
+
+	abis.forget(address)
+	return nil
+
+}
+
+// args - Given unpacked values, in same order as arguments are
+// defined in ABI, converts them into JSON friendly form
+func args(arguments abi.Arguments, values []interface{}) []*d.DecodedArg {
+
+	decoded := make([]*d.DecodedArg, 0, len(arguments))
+
+	for k, v := range arguments {
+
+		decoded = append(decoded, &d.DecodedArg{
+			Name:    v.Name,
+			Type:    v.Type.String(),
+			Indexed: v.Indexed,
+			Value:   normalise(v.Type, values[k]),
+		})
+
+	}
+
+	return decoded
+
+}
+
+// Event - Decodes event log using ABI of emitter contract, returns
+// nil if ABI isn't known or log can't be decoded using it
+//
+// Anonymous events can't be decoded, because they've no topic
+// identifying them
+func Event(_db *gorm.DB, origin string, topics []string, data []byte) *d.DecodedEvent {
+
+	if len(topics) == 0 {
+		return nil
+	}
+
+	contract := lookup(_db, origin)
+	if contract == nil {
+		return nil
+	}
+
+	event, err := contract.EventByID(common.HexToHash(topics[0]))
+	if err != nil {
+		return nil
+	}
+
+	indexed := make(abi.Arguments, 0, len(event.Inputs))
+	for _, v := range event.Inputs {
+		if v.Indexed {
+			indexed = append(indexed, v)
+		}
+	}
+
+	if len(indexed) != len(topics)-1 {
+		return nil
+	}
+
+	values := make(map[string]interface{})
+
+	if err := event.Inputs.UnpackIntoMap(values, data); err != nil {
+
+		// `ette` delivers event data consisting of single zero
+		// word as empty, so giving it another chance
+		if len(data) != 0 {
+			return nil
+		}
+
+		if err := event.Inputs.UnpackIntoMap(values, make([]byte, 32)); err != nil {
+			return nil
+		}
+
+	}
+
+	_topics := make([]common.Hash, 0, len(indexed))
+	for _, v := range topics[1:] {
+		_topics = append(_topics, common.HexToHash(v))
+	}
+
+	if err := abi.ParseTopicsIntoMap(values, indexed, _topics); err != nil {
+		return nil
+	}
+
+	// Unnamed event arguments are named by their position, when ABI is
+	// parsed, so they're uniquely identifiable by name
+	ordered := make([]interface{}, len(event.Inputs))
+	for k, v := range event.Inputs {
+		ordered[k] = values[v.Name]
+	}
+
+	return &d.DecodedEvent{
+		Name:      event.Name,
+		Signature: event.Sig,
+		Args:      args(event.Inputs, ordered),
+	}
+
+}
+
+// Call - Decodes tx input data using ABI of contract being invoked,
+// returns nil if ABI isn't known or input can't be decoded using it
+func Call(_db *gorm.DB, to string, input []byte) *d.DecodedCall {
+
+	if len(input) < 4 {
+		return nil
+	}
+
+	contract := lookup(_db, to)
+	if contract == nil {
+		return nil
+	}
+
+	method, err := contract.MethodById(input[:4])
+	if err != nil {
+		return nil
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil || len(values) != len(method.Inputs) {
+		return nil
+	}
+
+	return &d.DecodedCall{
+		Method:    method.Name,
+		Signature: method.Sig,
+		Inputs:    args(method.Inputs, values),
+	}
+
+}
+
+// DecodeEvent - Attaches decoded form to event log, if possible
+func DecodeEvent(_db *gorm.DB, event *d.Event) *d.Event {
+
+	if event == nil {
+		return nil
+	}
+
+	event.Decoded = Event(_db, event.Origin, event.Topics, event.Data)
+	return event
+
+}
+
+// DecodeEvents - Attaches decoded form to each of event logs, if possible
+func DecodeEvents(_db *gorm.DB, events *d.Events) *d.Events {
+
+	if events == nil {
+		return nil
+	}
+
+	for _, v := range events.Events {
+		DecodeEvent(_db, v)
+	}
+
+	return events
+
+}
+
+// DecodeTransaction - Attaches decoded form of input data to tx, if possible
+//
+// Contract creation tx(s) are left as it's
+func DecodeTransaction(_db *gorm.DB, tx *d.Transaction) *d.Transaction {
+
+	if tx == nil {
+		return nil
+	}
+
+	if strings.HasPrefix(tx.To, "0x") {
+		tx.Decoded = Call(_db, tx.To, tx.Data)
+	}
+
+	return tx
+
+}
+
+// DecodeTransactions - Attaches decoded form of input data to each of tx(s), if possible
+func DecodeTransactions(_db *gorm.DB, txs *d.Transactions) *d.Transactions {
+
+	if txs == nil {
+		return nil
+	}
+
+	for _, v := range txs.Transactions {
+		DecodeTransaction(_db, v)
+	}
+
+	return txs
+
+}
@@ -0,0 +1,290 @@
+package decoder
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const erc20 = `[
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[
+		{"name":"to","type":"address"},
+		{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// register - Puts parsed ABI of contract in cache, so that it's found
+// without looking up database
+func register(t *testing.T, address common.Address, abiJSON string) {
+
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("failed to parse ABI : %s", err.Error())
+	}
+
+	abis.put(address, &parsed)
+
+}
+
+// encoded - JSON encoding of decoded value, for comparing it with expected one
+func encoded(t *testing.T, v interface{}) string {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode : %s", err.Error())
+	}
+
+	return string(data)
+
+}
+
+func TestEvent(t *testing.T) {
+
+	var (
+		token   = common.HexToAddress("0x10")
+		from    = common.HexToAddress("0x1")
+		to      = common.HexToAddress("0x2")
+		unknown = common.HexToAddress("0x11")
+	)
+
+	register(t, token, erc20)
+	abis.put(unknown, nil)
+
+	fromTopic := common.BytesToHash(from.Bytes()).Hex()
+	toTopic := common.BytesToHash(to.Bytes()).Hex()
+
+	transfer := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex()
+
+	tests := []struct {
+		name    string
+		origin  string
+		topics  []string
+		data    []byte
+		decoded string
+	}{
+		{
+			name:    "transfer",
+			origin:  token.Hex(),
+			topics:  []string{transfer, fromTopic, toTopic},
+			data:    common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+			decoded: `{"name":"Transfer","signature":"Transfer(address,address,uint256)","args":[{"name":"from","type":"address","indexed":true,"value":"` + from.Hex() + `"},{"name":"to","type":"address","indexed":true,"value":"` + to.Hex() + `"},{"name":"value","type":"uint256","value":"100"}]}`,
+		},
+		{
+			name:    "transfer of zero, delivered as empty data",
+			origin:  token.Hex(),
+			topics:  []string{transfer, fromTopic, toTopic},
+			decoded: `{"name":"Transfer","signature":"Transfer(address,address,uint256)","args":[{"name":"from","type":"address","indexed":true,"value":"` + from.Hex() + `"},{"name":"to","type":"address","indexed":true,"value":"` + to.Hex() + `"},{"name":"value","type":"uint256","value":"0"}]}`,
+		},
+		{
+			name:   "indexed argument count mismatch",
+			origin: token.Hex(),
+			topics: []string{transfer, fromTopic},
+			data:   common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+		},
+		{
+			name:   "unknown event",
+			origin: token.Hex(),
+			topics: []string{crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")).Hex(), fromTopic, toTopic},
+			data:   common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+		},
+		{
+			name:   "malformed data",
+			origin: token.Hex(),
+			topics: []string{transfer, fromTopic, toTopic},
+			data:   []byte{1, 2, 3},
+		},
+		{
+			name:   "anonymous event",
+			origin: token.Hex(),
+		},
+		{
+			name:   "unknown contract",
+			origin: unknown.Hex(),
+			topics: []string{transfer, fromTopic, toTopic},
+			data:   common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+		},
+		{
+			name:   "bad origin",
+			origin: "0x",
+			topics: []string{transfer, fromTopic, toTopic},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			decoded := Event(nil, tt.origin, tt.topics, tt.data)
+
+			if tt.decoded == "" {
+
+				if decoded != nil {
+					t.Fatalf("expected not to be decoded, got %s", encoded(t, decoded))
+				}
+
+				return
+
+			}
+
+			if decoded == nil {
+				t.Fatalf("expected to be decoded")
+			}
+
+			if got := encoded(t, decoded); got != tt.decoded {
+				t.Errorf("expected %s, got %s", tt.decoded, got)
+			}
+
+		})
+
+	}
+
+}
+
+func TestCall(t *testing.T) {
+
+	var (
+		token   = common.HexToAddress("0x20")
+		to      = common.HexToAddress("0x2")
+		unknown = common.HexToAddress("0x21")
+	)
+
+	register(t, token, erc20)
+	abis.put(unknown, nil)
+
+	parsed, _ := abi.JSON(strings.NewReader(erc20))
+	input, err := parsed.Pack("transfer", to, big.NewInt(100))
+	if err != nil {
+		t.Fatalf("failed to pack input : %s", err.Error())
+	}
+
+	tests := []struct {
+		name    string
+		to      string
+		input   []byte
+		decoded string
+	}{
+		{
+			name:    "transfer",
+			to:      token.Hex(),
+			input:   input,
+			decoded: `{"method":"transfer","signature":"transfer(address,uint256)","inputs":[{"name":"to","type":"address","value":"` + to.Hex() + `"},{"name":"value","type":"uint256","value":"100"}]}`,
+		},
+		{
+			name:  "truncated input",
+			to:    token.Hex(),
+			input: input[:36],
+		},
+		{
+			name:  "unknown method",
+			to:    token.Hex(),
+			input: append([]byte{0xde, 0xad, 0xbe, 0xef}, input[4:]...),
+		},
+		{
+			name:  "no selector",
+			to:    token.Hex(),
+			input: input[:3],
+		},
+		{
+			name:  "unknown contract",
+			to:    unknown.Hex(),
+			input: input,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			decoded := Call(nil, tt.to, tt.input)
+
+			if tt.decoded == "" {
+
+				if decoded != nil {
+					t.Fatalf("expected not to be decoded, got %s", encoded(t, decoded))
+				}
+
+				return
+
+			}
+
+			if decoded == nil {
+				t.Fatalf("expected to be decoded")
+			}
+
+			if got := encoded(t, decoded); got != tt.decoded {
+				t.Errorf("expected %s, got %s", tt.decoded, got)
+			}
+
+		})
+
+	}
+
+}
+
+func TestNormalise(t *testing.T) {
+
+	newType := func(t *testing.T, name string, components []abi.ArgumentMarshaling) abi.Type {
+
+		_type, err := abi.NewType(name, "", components)
+		if err != nil {
+			t.Fatalf("failed to create type %s : %s", name, err.Error())
+		}
+
+		return _type
+
+	}
+
+	tests := []struct {
+		name       string
+		_type      string
+		components []abi.ArgumentMarshaling
+		value      interface{}
+		normalised string
+	}{
+		{name: "uint256", _type: "uint256", value: big.NewInt(1 << 40), normalised: `"1099511627776"`},
+		{name: "uint8", _type: "uint8", value: uint8(7), normalised: `"7"`},
+		{name: "int64", _type: "int64", value: int64(-7), normalised: `"-7"`},
+		{name: "address", _type: "address", value: common.HexToAddress("0xabc"), normalised: `"` + common.HexToAddress("0xabc").Hex() + `"`},
+		{name: "bytes", _type: "bytes", value: []byte{1, 2}, normalised: `"0x0102"`},
+		{name: "bytes4", _type: "bytes4", value: [4]byte{1, 2, 3, 4}, normalised: `"0x01020304"`},
+		{name: "bool", _type: "bool", value: true, normalised: `true`},
+		{name: "string", _type: "string", value: "ette", normalised: `"ette"`},
+		{name: "uint256 array", _type: "uint256[]", value: []*big.Int{big.NewInt(1), big.NewInt(2)}, normalised: `["1","2"]`},
+		{name: "fixed address array", _type: "address[1]", value: [1]common.Address{common.HexToAddress("0x1")}, normalised: `["` + common.HexToAddress("0x1").Hex() + `"]`},
+		{
+			name:  "tuple",
+			_type: "tuple",
+			components: []abi.ArgumentMarshaling{
+				{Name: "id", Type: "uint256"},
+				{Name: "owner", Type: "address"},
+			},
+			value: struct {
+				Id    *big.Int
+				Owner common.Address
+			}{big.NewInt(9), common.HexToAddress("0x1")},
+			normalised: `{"id":"9","owner":"` + common.HexToAddress("0x1").Hex() + `"}`,
+		},
+		{name: "nil", _type: "uint256", value: nil, normalised: `null`},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			if got := encoded(t, normalise(newType(t, tt._type, tt.components), tt.value)); got != tt.normalised {
+				t.Errorf("expected %s, got %s", tt.normalised, got)
+			}
+
+		})
+
+	}
+
+}
@@ -0,0 +1,84 @@
+package decoder
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// normalise - Converts value unpacked using ABI into JSON friendly form,
+// guided by its ABI type, where integers are kept as decimal strings, so
+// that no precision is lost, addresses are checksummed & byte arrays are
+// hex encoded
+//
+// Tuples become objects keyed by component name, as found in ABI
+func normalise(t abi.Type, value interface{}) interface{} {
+
+	if value == nil {
+		return nil
+	}
+
+	_v := reflect.ValueOf(value)
+
+	switch t.T {
+
+	case abi.IntTy, abi.UintTy:
+
+		if v, ok := value.(*big.Int); ok {
+			return v.String()
+		}
+
+		return fmt.Sprintf("%d", value)
+
+	case abi.AddressTy:
+
+		if v, ok := value.(common.Address); ok {
+			return v.Hex()
+		}
+
+	case abi.BytesTy, abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+
+		if _v.Kind() == reflect.Array || _v.Kind() == reflect.Slice {
+
+			buffer := make([]byte, _v.Len())
+			reflect.Copy(reflect.ValueOf(buffer), _v)
+
+			return hexutil.Encode(buffer)
+
+		}
+
+	case abi.SliceTy, abi.ArrayTy:
+
+		values := make([]interface{}, _v.Len())
+		for i := 0; i < _v.Len(); i++ {
+			values[i] = normalise(*t.Elem, _v.Index(i).Interface())
+		}
+
+		return values
+
+	case abi.TupleTy:
+
+		if _v.Kind() == reflect.Ptr {
+			_v = _v.Elem()
+		}
+
+		if _v.Kind() != reflect.Struct || _v.NumField() != len(t.TupleElems) {
+			return value
+		}
+
+		values := make(map[string]interface{})
+		for i, v := range t.TupleElems {
+			values[t.TupleRawNames[i]] = normalise(*v, _v.Field(i).Interface())
+		}
+
+		return values
+
+	}
+
+	return value
+
+}
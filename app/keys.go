@@ -0,0 +1,86 @@
+package app
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gookit/color"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// ListKeys - Lists all API keys created by given account, along with
+// their enabled state
+//
+// Config file must be read before invoking it
+func ListKeys(address common.Address) bool {
+
+	apps := db.GetAppsByUserAddress(db.Connect(), address)
+	if apps == nil {
+
+		log.Printf("[*] No API keys found for `%s`\n", address.Hex())
+		return true
+
+	}
+
+	for _, v := range apps {
+		fmt.Printf("%s\t%t\t%s\n", v.APIKey, v.Enabled, v.TimeStamp.Format("2006-01-02 15:04:05"))
+	}
+
+	return true
+
+}
+
+// CreateKey - Creates new API key for given account, while subscribing it to
+// default subscription plan, if not subscribed to any yet
+//
+// Config file must be read before invoking it
+func CreateKey(subscriptionPlansFile string, address common.Address) bool {
+
+	_db := db.Connect()
+
+	// Default subscription plan to be assigned to account,
+	// must be present in database
+	db.PersistAllSubscriptionPlans(_db, subscriptionPlansFile)
+
+	if !db.RegisterNewApp(_db, address) {
+
+		log.Print(color.Red.Sprintf("[!] Failed to create API key for `%s`", address.Hex()))
+		return false
+
+	}
+
+	apps := db.GetAppsByUserAddress(_db, address)
+	if apps == nil {
+		return false
+	}
+
+	// Apps are ordered by creation time, in descending order
+	fmt.Println(apps[0].APIKey)
+	return true
+
+}
+
+// ToggleKey - Enables API key if disabled, otherwise disables it
+//
+// Config file must be read before invoking it
+func ToggleKey(apiKey string) bool {
+
+	_db := db.Connect()
+
+	if !db.ToggleAPIKeyState(_db, apiKey) {
+
+		log.Print(color.Red.Sprintf("[!] Failed to toggle state of API key `%s`", apiKey))
+		return false
+
+	}
+
+	user := db.GetUserFromAPIKey(_db, apiKey)
+	if user == nil {
+		return false
+	}
+
+	fmt.Printf("%s\t%t\n", user.APIKey, user.Enabled)
+	return true
+
+}
@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	d "github.com/itzmeanjan/ette/app/data"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	blocksProcessedDesc = prometheus.NewDesc("ette_blocks_processed_total", "Blocks processed since `ette` started", nil, nil)
+	blocksInsertedDesc  = prometheus.NewDesc("ette_blocks_inserted_total", "Blocks inserted into database since `ette` started", nil, nil)
+	blocksInDBDesc      = prometheus.NewDesc("ette_blocks_in_db", "Blocks present in database", nil, nil)
+	latestBlockDesc     = prometheus.NewDesc("ette_latest_block_number", "Latest block number seen by head listener", nil, nil)
+	headLagDesc         = prometheus.NewDesc("ette_head_lag_blocks", "How far latest block seen by head listener is behind best known head of blockchain nodes", nil, nil)
+	queueDesc           = prometheus.NewDesc("ette_queue_blocks", "Blocks in block processor queue, by state", []string{"state"}, nil)
+	queueTotalDesc      = prometheus.NewDesc("ette_queue_blocks_total", "Blocks ever put into block processor queue", nil, nil)
+	endpointHealthyDesc = prometheus.NewDesc("ette_endpoint_healthy", "Whether blockchain node endpoint is healthy or not", []string{"kind", "index", "host"}, nil)
+	endpointHeadDesc    = prometheus.NewDesc("ette_endpoint_head", "Latest block number of blockchain node endpoint, as of last health check", []string{"kind", "index", "host"}, nil)
+	endpointLatencyDesc = prometheus.NewDesc("ette_endpoint_latency_seconds", "Latency of blockchain node endpoint, as of last health check", []string{"kind", "index", "host"}, nil)
+	endpointErrorsDesc  = prometheus.NewDesc("ette_endpoint_errors_total", "Failed operations performed using blockchain node endpoint", []string{"kind", "index", "host"}, nil)
+)
+
+// stateCollector - Collects ingestion progress, block processor queue state &
+// blockchain node endpoint health, when scraped, from where `ette` keeps them
+type stateCollector struct {
+	status     *d.StatusHolder
+	queue      *q.BlockProcessorQueue
+	connection *d.BlockChainNodeConnection
+}
+
+// Describe - Sends descriptors of all metrics collected
+func (s *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+
+	for _, v := range []*prometheus.Desc{
+		blocksProcessedDesc, blocksInsertedDesc, blocksInDBDesc, latestBlockDesc, headLagDesc,
+		queueDesc, queueTotalDesc,
+		endpointHealthyDesc, endpointHeadDesc, endpointLatencyDesc, endpointErrorsDesc,
+	} {
+		ch <- v
+	}
+
+}
+
+// endpointHost - Host & port of blockchain node endpoint, so that API keys,
+// often present in path/ query/ credentials of URL, aren't exposed
+func endpointHost(endpoint string, index int) string {
+
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return fmt.Sprintf("endpoint-%d", index)
+
+}
+
+// collectEndpoints - Collects health of all endpoints in pool, returning
+// best known head among healthy ones
+func (s *stateCollector) collectEndpoints(ch chan<- prometheus.Metric, kind string, pool *d.EndpointPool) uint64 {
+
+	pool.Lock.RLock()
+	defer pool.Lock.RUnlock()
+
+	var head uint64
+
+	for k, v := range pool.Endpoints {
+
+		index := strconv.Itoa(k)
+		host := endpointHost(v.URL, k)
+
+		healthy := 0.0
+		if v.Healthy {
+
+			healthy = 1.0
+
+			if v.Head > head {
+				head = v.Head
+			}
+
+		}
+
+		ch <- prometheus.MustNewConstMetric(endpointHealthyDesc, prometheus.GaugeValue, healthy, kind, index, host)
+		ch <- prometheus.MustNewConstMetric(endpointHeadDesc, prometheus.GaugeValue, float64(v.Head), kind, index, host)
+		ch <- prometheus.MustNewConstMetric(endpointLatencyDesc, prometheus.GaugeValue, v.Latency.Seconds(), kind, index, host)
+		ch <- prometheus.MustNewConstMetric(endpointErrorsDesc, prometheus.CounterValue, float64(v.Errors), kind, index, host)
+
+	}
+
+	return head
+
+}
+
+// Collect - Reads current state & sends them as metrics
+func (s *stateCollector) Collect(ch chan<- prometheus.Metric) {
+
+	latest := s.status.GetLatestBlockNumber()
+
+	ch <- prometheus.MustNewConstMetric(blocksProcessedDesc, prometheus.CounterValue, float64(s.status.Done()))
+	ch <- prometheus.MustNewConstMetric(blocksInsertedDesc, prometheus.CounterValue, float64(s.status.BlocksInserted()))
+	ch <- prometheus.MustNewConstMetric(blocksInDBDesc, prometheus.GaugeValue, float64(s.status.BlockCountInDB()))
+	ch <- prometheus.MustNewConstMetric(latestBlockDesc, prometheus.GaugeValue, float64(latest))
+
+	stat := s.queue.Stat()
+
+	ch <- prometheus.MustNewConstMetric(queueDesc, prometheus.GaugeValue, float64(stat.UnconfirmedProgress), "unconfirmed_progress")
+	ch <- prometheus.MustNewConstMetric(queueDesc, prometheus.GaugeValue, float64(stat.UnconfirmedWaiting), "unconfirmed_waiting")
+	ch <- prometheus.MustNewConstMetric(queueDesc, prometheus.GaugeValue, float64(stat.ConfirmedProgress), "confirmed_progress")
+	ch <- prometheus.MustNewConstMetric(queueDesc, prometheus.GaugeValue, float64(stat.ConfirmedWaiting), "confirmed_waiting")
+	ch <- prometheus.MustNewConstMetric(queueTotalDesc, prometheus.CounterValue, float64(stat.Total))
+
+	head := s.collectEndpoints(ch, "rpc", s.connection.RPC)
+	if _head := s.collectEndpoints(ch, "websocket", s.connection.Websocket); _head > head {
+		head = _head
+	}
+
+	var lag uint64
+	if head > latest {
+		lag = head - latest
+	}
+
+	ch <- prometheus.MustNewConstMetric(headLagDesc, prometheus.GaugeValue, float64(lag))
+
+}
+
+// Register - Registers collector of ingestion progress, block processor queue
+// state & blockchain node endpoint health, to be invoked once, during start up
+//
+// Block processor queue must be running, because its state is asked for,
+// every time metrics are scraped
+func Register(status *d.StatusHolder, queue *q.BlockProcessorQueue, connection *d.BlockChainNodeConnection) {
+
+	if err := prometheus.Register(&stateCollector{status: status, queue: queue, connection: connection}); err != nil {
+		log.Printf("[!] Failed to register metrics collector : %s\n", err.Error())
+	}
+
+}
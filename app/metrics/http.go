@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Middleware - Records time taken to serve each HTTP request, labelled with
+// matched route template, so that path params don't blow up cardinality
+//
+// Websocket & Server-Sent Events connections are skipped, because they live
+// as long as client wants
+func Middleware() gin.HandlerFunc {
+
+	return func(c *gin.Context) {
+
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "/v1/ws" || route == "/v1/sse" {
+			return
+		}
+
+		if route == "" {
+			route = "unmatched"
+		}
+
+		httpDuration.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Observe(time.Since(start).Seconds())
+
+	}
+
+}
+
+// Handler - Exposes all collected metrics, in Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve - Exposes metrics at `/metrics`, on its own listen address, so that
+// they're not reachable through public facing API port
+func Serve(address string) {
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Printf("[!] Failed to expose metrics on %s : %s\n", address, err.Error())
+	}
+
+}
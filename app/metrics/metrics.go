@@ -0,0 +1,194 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// rpcDuration - Time taken by JSON-RPC calls made to blockchain node, per method
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ette",
+		Name:      "rpc_request_duration_seconds",
+		Help:      "Time taken by JSON-RPC calls made to blockchain node",
+	}, []string{"method"})
+
+	// rpcErrors - Failed JSON-RPC calls made to blockchain node, per method
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ette",
+		Name:      "rpc_request_errors_total",
+		Help:      "Failed JSON-RPC calls made to blockchain node",
+	}, []string{"method"})
+
+	// storeBlockDuration - Time taken to persist whole block in database
+	storeBlockDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ette",
+		Name:      "db_store_block_duration_seconds",
+		Help:      "Time taken to persist whole block in database",
+	}, []string{"status"})
+
+	// bulkWriteDuration - Time taken to persist batch of blocks in database, in bulk
+	bulkWriteDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ette",
+		Name:      "db_bulk_write_duration_seconds",
+		Help:      "Time taken to persist batch of blocks in database, in bulk",
+	}, []string{"status"})
+
+	// brokerPublishFailures - Failed attempts to publish on topic, per broker backend
+	brokerPublishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ette",
+		Name:      "broker_publish_failures_total",
+		Help:      "Failed attempts to publish on topic, using broker",
+	}, []string{"backend", "topic"})
+
+	// webhookDeliveries - Attempts to deliver data to webhooks, per result
+	webhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ette",
+		Name:      "webhook_deliveries_total",
+		Help:      "Attempts to deliver real-time data to webhooks",
+	}, []string{"result"})
+
+	// httpDuration - Time taken to serve HTTP requests, per route & status code
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ette",
+		Name:      "http_request_duration_seconds",
+		Help:      "Time taken to serve HTTP requests",
+	}, []string{"method", "route", "status"})
+
+	// websocketSubscriptions - Active websocket subscriptions, per topic
+	websocketSubscriptions = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ette",
+		Name:      "websocket_subscriptions",
+		Help:      "Active websocket subscriptions",
+	}, []string{"topic"})
+
+	// replicaLag - How far read replica is behind primary, in terms of blocks
+	replicaLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ette",
+		Name:      "db_replica_lag_blocks",
+		Help:      "How far read replica of database is behind primary, in terms of blocks",
+	}, []string{"replica"})
+
+	// replicaHealthy - Whether read replica is being used for serving queries or not
+	replicaHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ette",
+		Name:      "db_replica_healthy",
+		Help:      "Whether read replica of database is being used for serving queries or not",
+	}, []string{"replica"})
+
+	// prunedRows - Rows removed by retention service, per table
+	prunedRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ette",
+		Name:      "pruned_rows_total",
+		Help:      "Rows removed by retention service",
+	}, []string{"table"})
+
+	// integrityFindings - Inconsistencies found in persisted blocks, per kind
+	integrityFindings = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ette",
+		Name:      "integrity_findings",
+		Help:      "Inconsistencies found in persisted blocks, by integrity verifier",
+	}, []string{"kind"})
+
+	// integrityBlocksVerified - Persisted blocks verified by integrity verifier
+	integrityBlocksVerified = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "ette",
+		Name:      "integrity_blocks_verified_total",
+		Help:      "Persisted blocks verified by integrity verifier",
+	})
+)
+
+// status - Outcome of operation, to be used as label value
+func status(err error) string {
+
+	if err != nil {
+		return "failure"
+	}
+
+	return "success"
+
+}
+
+// ObserveRPC - Records time taken by JSON-RPC call, which started at
+// given time, along with whether it failed or not
+func ObserveRPC(method string, start time.Time, err error) {
+
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		rpcErrors.WithLabelValues(method).Inc()
+	}
+
+}
+
+// ObserveStoreBlock - Records time taken to persist block, which
+// started at given time, along with whether it failed or not
+func ObserveStoreBlock(start time.Time, err error) {
+	storeBlockDuration.WithLabelValues(status(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveBulkWrite - Records time taken to persist batch of blocks in
+// bulk, which started at given time, along with whether it failed or not
+func ObserveBulkWrite(start time.Time, err error) {
+	bulkWriteDuration.WithLabelValues(status(err)).Observe(time.Since(start).Seconds())
+}
+
+// BrokerPublishFailed - Records failed attempt to publish on topic, using
+// given broker backend i.e. {redis, memory, nats}
+func BrokerPublishFailed(backend string, topic string) {
+	brokerPublishFailures.WithLabelValues(backend, topic).Inc()
+}
+
+// WebhookDelivered - Records outcome of attempt to deliver data to webhook,
+// where result is one of `delivered`, `failed`, `dead`
+func WebhookDelivered(result string) {
+	webhookDeliveries.WithLabelValues(result).Inc()
+}
+
+// AddWebsocketSubscriptions - Updates active websocket subscription
+// count of topic, delta being negative when unsubscribed
+func AddWebsocketSubscriptions(topic string, delta int) {
+	websocketSubscriptions.WithLabelValues(topic).Add(float64(delta))
+}
+
+// SetReplicaLag - Records state of read replica, as of last health check
+func SetReplicaLag(replica string, lag uint64, healthy bool) {
+
+	replicaLag.WithLabelValues(replica).Set(float64(lag))
+
+	if healthy {
+		replicaHealthy.WithLabelValues(replica).Set(1)
+		return
+	}
+
+	replicaHealthy.WithLabelValues(replica).Set(0)
+
+}
+
+// Pruned - Records how many more rows were removed from table
+func Pruned(table string, count int64) {
+	prunedRows.WithLabelValues(table).Add(float64(count))
+}
+
+// SetIntegrityFindings - Updates how many inconsistencies are known to be
+// present in persisted blocks, per kind, forgetting previous ones
+func SetIntegrityFindings(counts map[string]uint64) {
+
+	if counts == nil {
+		return
+	}
+
+	integrityFindings.Reset()
+
+	for k, v := range counts {
+		integrityFindings.WithLabelValues(k).Set(float64(v))
+	}
+
+}
+
+// IntegrityBlocksVerified - Records how many more persisted blocks were verified
+func IntegrityBlocksVerified(count int) {
+	integrityBlocksVerified.Add(float64(count))
+}
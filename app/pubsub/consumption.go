@@ -1,15 +1,19 @@
 package pubsub
 
 import (
+	"fmt"
+	"log"
 	"sync"
+	"time"
 
-	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
+	"github.com/itzmeanjan/ette/app/broker"
 	"github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
 	"gorm.io/gorm"
 )
 
-// Consumer - Block, transaction & event consumers need to implement these methods
+// Consumer - Block, transaction, event, reorg, transfer & pending consumers need to implement these methods
 type Consumer interface {
 	Subscribe()
 	Listen()
@@ -18,12 +22,241 @@ type Consumer interface {
 	Unsubscribe()
 }
 
-// NewBlockConsumer - Creating one new block data consumer, which will subscribe to block
-// topic & listen for data being published on this channel, which will eventually be
-// delivered to client application over websocket connection
-func NewBlockConsumer(client *redis.Client, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *BlockConsumer {
-	consumer := BlockConsumer{
-		Client:     client,
+// Replayer - Block, transaction & event consumers, which can deliver data read
+// from database, before switching to live data, need to implement these methods
+type Replayer interface {
+	Consumer
+	Replay(req *SubscriptionRequest, msg string) bool
+}
+
+// listen - Keeps delivering data received on subscription, in order, until
+// it's closed
+//
+// `before` is invoked, if given, before waiting for next piece of data, which
+// happens at least once every second
+func listen(sub broker.Subscription, before func(), send func(string)) {
+
+	for {
+
+		if before != nil {
+			before()
+		}
+
+		msg, err := sub.Next(time.Second)
+		switch err {
+
+		case nil:
+			send(msg)
+		case broker.ErrTimeout:
+			continue
+		default:
+			return
+
+		}
+
+	}
+
+}
+
+// topicConsumer - Skeleton shared by consumers of all topics, which subscribes
+// to topic, keeps receiving data published on it, delivers it to client
+// application, connected over websocket, when user is allowed to receive it
+// & unsubscribes
+//
+// Consumer of each topic embeds it, while only finding out which subscription
+// request published data is matching with & what's to be delivered
+type topicConsumer struct {
+	Topic        string
+	Broker       broker.Broker
+	Requests     map[string]*SubscriptionRequest
+	Connection   *websocket.Conn
+	Subscription broker.Subscription
+	DB           *gorm.DB
+	ConnLock     *sync.Mutex
+	TopicLock    *sync.RWMutex
+	Counter      *data.SendReceiveCounter
+}
+
+// Subscribe - Subscribe to topic, using broker, for receiving data
+// published on it from now on
+func (t *topicConsumer) Subscribe() {
+
+	sub, err := t.Broker.Subscribe(t.Topic)
+	if err != nil {
+		log.Printf("[!] Failed to subscribe to `%s` topic : %s\n", t.Topic, err.Error())
+		return
+	}
+
+	t.Subscription = sub
+
+}
+
+// consume - Lets client know whether it's subscribed to topic, then keeps
+// handing over data published on it, in order, until unsubscribed
+func (t *topicConsumer) consume(before func(), send func(string)) {
+
+	if t.Subscription == nil {
+
+		t.SendData(&SubscriptionResponse{
+			Code:    0,
+			Message: fmt.Sprintf("Failed to subscribe to `%s`", t.Topic),
+		})
+		return
+
+	}
+
+	t.SendData(&SubscriptionResponse{
+		Code:    1,
+		Message: fmt.Sprintf("Subscribed to `%s`", t.Topic),
+	})
+
+	listen(t.Subscription, before, send)
+
+}
+
+// first - Finds first subscription request, published data is matching with
+func (t *topicConsumer) first(match func(*SubscriptionRequest) bool) *SubscriptionRequest {
+
+	// -- Shared memory being read from concurrently
+	// running thread of execution, with lock
+	t.TopicLock.RLock()
+	defer t.TopicLock.RUnlock()
+
+	for _, v := range t.Requests {
+
+		if match(v) {
+			return v
+		}
+
+	}
+
+	return nil
+
+}
+
+// reject - Lets client know why data can't be delivered to it
+func (t *topicConsumer) reject(message string) {
+
+	// -- Critical section of code begins
+	//
+	// Attempting to write to a network resource,
+	// shared among multiple go routines
+	t.ConnLock.Lock()
+	defer t.ConnLock.Unlock()
+
+	if err := t.Connection.WriteJSON(&SubscriptionResponse{
+		Code:    0,
+		Message: message,
+	}); err != nil {
+		log.Printf("[!] Failed to deliver `%s` message to client : %s\n", message, err.Error())
+	}
+
+	// Because we're writing to socket
+	t.Counter.IncrementSend(1)
+
+}
+
+// deliver - Delivers data to client application, for subscription request
+// it's matching with, when API key is good & user is under rate limit, while
+// keeping track of how much of data is delivered, returning false only when
+// client can't be delivered anymore
+func (t *topicConsumer) deliver(request *SubscriptionRequest, data interface{}, size int) bool {
+
+	user := db.GetUserFromAPIKey(t.DB, request.APIKey)
+	if user == nil || !user.Enabled {
+
+		t.reject("Bad API Key")
+		return false
+
+	}
+
+	// Don't deliver data & close underlying connection
+	// if client has crossed it's allowed data delivery limit
+	if !db.IsUnderRateLimit(t.DB, user.Address) {
+
+		t.reject("Crossed Allowed Rate Limit")
+		return false
+
+	}
+
+	if !t.SendData(data) {
+		return false
+	}
+
+	db.PutDataDeliveryInfo(t.DB, user.Address, fmt.Sprintf("/v1/ws/%s", t.Topic), uint64(size))
+	return true
+
+}
+
+// SendData - Sending message to client application, connected over websocket
+//
+// If failed, we're going to remove subscription & close websocket
+// connection ( connection might be already closed though )
+func (t *topicConsumer) SendData(data interface{}) bool {
+
+	// -- Critical section of code begins
+	//
+	// Attempting to write to a network resource,
+	// shared among multiple go routines
+	t.ConnLock.Lock()
+	defer t.ConnLock.Unlock()
+
+	if err := t.Connection.WriteJSON(data); err != nil {
+		log.Printf("[!] Failed to deliver `%s` data to client : %s\n", t.Topic, err.Error())
+		return false
+	}
+
+	// Because we're writing to socket
+	t.Counter.IncrementSend(1)
+
+	return true
+
+}
+
+// Unsubscribe - Unsubscribe from topic, to be called when stopping to listen
+// data being published on it, due to client has requested a unsubscription/
+// network connection got hampered
+func (t *topicConsumer) Unsubscribe() {
+
+	if t.Subscription == nil {
+		log.Printf("[!] Bad attempt to unsubscribe from `%s` topic\n", t.Topic)
+		return
+	}
+
+	if err := t.Subscription.Close(); err != nil {
+		log.Printf("[!] Failed to unsubscribe from `%s` topic : %s\n", t.Topic, err.Error())
+		return
+	}
+
+	resp := &SubscriptionResponse{
+		Code:    1,
+		Message: fmt.Sprintf("Unsubscribed from `%s`", t.Topic),
+	}
+
+	// -- Critical section of code begins
+	//
+	// Attempting to write to a network resource,
+	// shared among multiple go routines
+	t.ConnLock.Lock()
+	defer t.ConnLock.Unlock()
+
+	if err := t.Connection.WriteJSON(resp); err != nil {
+
+		log.Printf("[!] Failed to deliver `%s` unsubscription confirmation to client : %s\n", t.Topic, err.Error())
+		return
+
+	}
+
+	// Because we're writing to socket
+	t.Counter.IncrementSend(1)
+
+}
+
+// newTopicConsumer - Creates skeleton of consumer, for given topic
+func newTopicConsumer(topic string, _broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) topicConsumer {
+	return topicConsumer{
+		Topic:      topic,
+		Broker:     _broker,
 		Requests:   requests,
 		Connection: conn,
 		DB:         db,
@@ -31,6 +264,15 @@ func NewBlockConsumer(client *redis.Client, requests map[string]*SubscriptionReq
 		TopicLock:  topicLock,
 		Counter:    counter,
 	}
+}
+
+// NewBlockConsumer - Creating one new block data consumer, which will subscribe to block
+// topic & listen for data being published on this channel, which will eventually be
+// delivered to client application over websocket connection
+func NewBlockConsumer(_broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *BlockConsumer {
+	consumer := BlockConsumer{
+		topicConsumer: newTopicConsumer("block", _broker, requests, conn, db, connLock, topicLock, counter),
+	}
 
 	consumer.Subscribe()
 	go consumer.Listen()
@@ -42,15 +284,9 @@ func NewBlockConsumer(client *redis.Client, requests map[string]*SubscriptionReq
 // topic & listen for data being published on this channel & check whether received data
 // is what, client is interested in or not, which will eventually be
 // delivered to client application over websocket connection
-func NewTransactionConsumer(client *redis.Client, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *TransactionConsumer {
+func NewTransactionConsumer(_broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *TransactionConsumer {
 	consumer := TransactionConsumer{
-		Client:     client,
-		Requests:   requests,
-		Connection: conn,
-		DB:         db,
-		ConnLock:   connLock,
-		TopicLock:  topicLock,
-		Counter:    counter,
+		topicConsumer: newTopicConsumer("transaction", _broker, requests, conn, db, connLock, topicLock, counter),
 	}
 
 	consumer.Subscribe()
@@ -63,15 +299,53 @@ func NewTransactionConsumer(client *redis.Client, requests map[string]*Subscript
 // topic & listen for data being published on this channel & check whether received data
 // is what, client is interested in or not, which will eventually be
 // delivered to client application over websocket connection
-func NewEventConsumer(client *redis.Client, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *EventConsumer {
+func NewEventConsumer(_broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *EventConsumer {
 	consumer := EventConsumer{
-		Client:     client,
-		Requests:   requests,
-		Connection: conn,
-		DB:         db,
-		ConnLock:   connLock,
-		TopicLock:  topicLock,
-		Counter:    counter,
+		topicConsumer: newTopicConsumer("event", _broker, requests, conn, db, connLock, topicLock, counter),
+	}
+
+	consumer.Subscribe()
+	go consumer.Listen()
+
+	return &consumer
+}
+
+// NewReorgConsumer - Creating one new reorg data consumer, which will subscribe to reorg
+// topic & listen for data being published on this channel, which will eventually be
+// delivered to client application over websocket connection
+func NewReorgConsumer(_broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *ReorgConsumer {
+	consumer := ReorgConsumer{
+		topicConsumer: newTopicConsumer("reorg", _broker, requests, conn, db, connLock, topicLock, counter),
+	}
+
+	consumer.Subscribe()
+	go consumer.Listen()
+
+	return &consumer
+}
+
+// NewTransferConsumer - Creating one new token transfer data consumer, which will subscribe to transfer
+// topic & listen for data being published on this channel & check whether received data
+// is what, client is interested in or not, which will eventually be
+// delivered to client application over websocket connection
+func NewTransferConsumer(_broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *TransferConsumer {
+	consumer := TransferConsumer{
+		topicConsumer: newTopicConsumer("transfer", _broker, requests, conn, db, connLock, topicLock, counter),
+	}
+
+	consumer.Subscribe()
+	go consumer.Listen()
+
+	return &consumer
+}
+
+// NewPendingConsumer - Creating one new pending tx data consumer, which will subscribe to pending
+// topic & listen for data being published on this channel & check whether received data
+// is what, client is interested in or not, which will eventually be
+// delivered to client application over websocket connection
+func NewPendingConsumer(_broker broker.Broker, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex, counter *data.SendReceiveCounter) *PendingConsumer {
+	consumer := PendingConsumer{
+		topicConsumer: newTopicConsumer("pending", _broker, requests, conn, db, connLock, topicLock, counter),
 	}
 
 	consumer.Subscribe()
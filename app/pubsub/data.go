@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
+	"github.com/itzmeanjan/ette/app/broker"
 	"github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/metrics"
 	"gorm.io/gorm"
 )
 
@@ -17,19 +18,19 @@ import (
 // over same websocket connection, one new pubsub subscription
 // may not be created
 //
-// For each client there could be possibly at max 3 pubsub subscriptions
-// i.e. block, transaction, event, which are considered to be top level
+// For each client there could be possibly at max 6 pubsub subscriptions
+// i.e. block, transaction, event, reorg, transfer, pending, which are considered to be top level
 // topics
 //
 // For each of them there could be multiple subtopics but not explicit
 // pubsub subscription
 //
 // This is being done for reducing redundant pressure on pubsub
-// broker i.e. Redis here 🥳
+// broker i.e. Redis, NATS or in-process one 🥳
 type SubscriptionManager struct {
 	Topics     map[string]map[string]*SubscriptionRequest
 	Consumers  map[string]Consumer
-	Client     *redis.Client
+	Broker     broker.Broker
 	Connection *websocket.Conn
 	DB         *gorm.DB
 	ConnLock   *sync.Mutex
@@ -45,6 +46,12 @@ func (s *SubscriptionManager) Subscribe(req *SubscriptionRequest) {
 	s.TopicLock.Lock()
 	defer s.TopicLock.Unlock()
 
+	// Live data to be buffered, from very beginning, until replay
+	// is caught up
+	if req.FromBlock != nil {
+		req.replay = newReplay()
+	}
+
 	_, ok := s.Topics[req.Topic()]
 	if !ok {
 
@@ -52,21 +59,33 @@ func (s *SubscriptionManager) Subscribe(req *SubscriptionRequest) {
 		tmp[req.Name] = req
 
 		s.Topics[req.Topic()] = tmp
+		metrics.AddWebsocketSubscriptions(req.Topic(), 1)
 
 		switch req.Topic() {
 
 		case "block":
-			s.Consumers[req.Topic()] = NewBlockConsumer(s.Client, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
+			s.Consumers[req.Topic()] = NewBlockConsumer(s.Broker, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
 		case "transaction":
-			s.Consumers[req.Topic()] = NewTransactionConsumer(s.Client, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
+			s.Consumers[req.Topic()] = NewTransactionConsumer(s.Broker, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
 		case "event":
-			s.Consumers[req.Topic()] = NewEventConsumer(s.Client, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
+			s.Consumers[req.Topic()] = NewEventConsumer(s.Broker, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
+		case "reorg":
+			s.Consumers[req.Topic()] = NewReorgConsumer(s.Broker, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
+		case "transfer":
+			s.Consumers[req.Topic()] = NewTransferConsumer(s.Broker, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
+		case "pending":
+			s.Consumers[req.Topic()] = NewPendingConsumer(s.Broker, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock, s.Counter)
 		}
 
+		s.startReplay(req)
 		return
 
 	}
 
+	if _, ok := s.Topics[req.Topic()][req.Name]; !ok {
+		metrics.AddWebsocketSubscriptions(req.Topic(), 1)
+	}
+
 	s.Topics[req.Topic()][req.Name] = req
 	s.Consumers[req.Topic()].SendData(
 		&SubscriptionResponse{
@@ -74,6 +93,26 @@ func (s *SubscriptionManager) Subscribe(req *SubscriptionRequest) {
 			Message: fmt.Sprintf("Subscribed to `%s`", req.Topic()),
 		})
 
+	s.startReplay(req)
+
+}
+
+// startReplay - Starts replaying data from database, for request asking for
+// it, in its own go routine, while consumer is already subscribed to topic
+func (s *SubscriptionManager) startReplay(req *SubscriptionRequest) {
+
+	if req.replay == nil {
+		return
+	}
+
+	consumer, ok := s.Consumers[req.Topic()].(Replayer)
+	if !ok {
+		req.replay = nil
+		return
+	}
+
+	go s.replay(consumer, req)
+
 }
 
 // Unsubscribe - Websocket connection manager can reliably call
@@ -95,6 +134,10 @@ func (s *SubscriptionManager) Unsubscribe(req *SubscriptionRequest) {
 		return
 	}
 
+	if _, ok := s.Topics[req.Topic()][req.Name]; ok {
+		metrics.AddWebsocketSubscriptions(req.Topic(), -1)
+	}
+
 	delete(s.Topics[req.Topic()], req.Name)
 
 	if len(s.Topics[req.Topic()]) > 0 {
@@ -113,3 +156,19 @@ func (s *SubscriptionManager) Unsubscribe(req *SubscriptionRequest) {
 	delete(s.Consumers, req.Topic())
 
 }
+
+// UnsubscribeAll - Unsubscribes from all pubsub topics, to be invoked
+// when websocket connection is getting closed
+func (s *SubscriptionManager) UnsubscribeAll() {
+
+	s.TopicLock.Lock()
+	defer s.TopicLock.Unlock()
+
+	for k, v := range s.Consumers {
+
+		v.Unsubscribe()
+		metrics.AddWebsocketSubscriptions(k, -len(s.Topics[k]))
+
+	}
+
+}
@@ -0,0 +1,48 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"log"
+
+	d "github.com/itzmeanjan/ette/app/data"
+)
+
+// PendingConsumer - Pending tx consumption to be managed by this struct, when new websocket
+// connection requests for receiving pending tx data, it'll create this struct, with necessary pieces
+// of information, which is to be required when delivering data & checking whether this connection
+// has really requested notification for this pending tx or not
+type PendingConsumer struct {
+	topicConsumer
+}
+
+// Listen - Listener function, which keeps receiving data published on `pending`
+// topic, in order, until unsubscribed, which also gets delivered to client
+// application
+func (t *PendingConsumer) Listen() {
+	t.consume(nil, t.Send)
+}
+
+// Send - Sending pending tx data to client application, which has subscribed to this
+// pending tx & connected over websocket
+func (t *PendingConsumer) Send(msg string) {
+
+	var tx d.PendingTransaction
+
+	_msg := []byte(msg)
+
+	if err := json.Unmarshal(_msg, &tx); err != nil {
+		log.Printf("[!] Failed to decode published pending tx data to JSON : %s\n", err.Error())
+		return
+	}
+
+	request := t.first(func(r *SubscriptionRequest) bool { return r.DoesMatchWithPublishedPendingTransactionData(&tx) })
+
+	// Can't proceed with this anymore, because failed to find
+	// respective subscription request
+	if request == nil {
+		return
+	}
+
+	t.deliver(request, &tx, len(msg))
+
+}
@@ -0,0 +1,48 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/itzmeanjan/ette/app/data"
+)
+
+// ReorgConsumer - To be subscribed to `reorg` topic using this consumer handle
+// and client connected using websocket needs to be delivered this piece of data,
+// so that it can discard whatever it received for orphaned blocks
+type ReorgConsumer struct {
+	topicConsumer
+}
+
+// Listen - Listener function, which keeps receiving data published on `reorg`
+// topic, in order, until unsubscribed, which also gets delivered to client
+// application
+func (r *ReorgConsumer) Listen() {
+	r.consume(nil, r.Send)
+}
+
+// Send - Tries to deliver subscribed reorg data to client application
+// connected over websocket
+func (r *ReorgConsumer) Send(msg string) {
+
+	var reorg data.Reorg
+
+	_msg := []byte(msg)
+
+	if err := json.Unmarshal(_msg, &reorg); err != nil {
+		log.Printf("[!] Failed to decode published reorg data to JSON : %s\n", err.Error())
+		return
+	}
+
+	// Every request is matching with reorg data
+	request := r.first(func(*SubscriptionRequest) bool { return true })
+
+	// Can't proceed with this anymore, because failed to find
+	// respective subscription request
+	if request == nil {
+		return
+	}
+
+	r.deliver(request, &reorg, len(msg))
+
+}
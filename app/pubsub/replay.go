@@ -0,0 +1,325 @@
+package pubsub
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// replay - Progress of subscription request, asking for data starting from some
+// block in past, which is first delivered from database, while live data received
+// in the meantime is kept aside, to be delivered once it's caught up
+type replay struct {
+	Done   bool
+	Live   bool
+	Buffer []string
+	Hashes map[string]bool
+	Lock   *sync.Mutex
+}
+
+// newReplay - Creates state for request, which is yet to be replayed
+func newReplay() *replay {
+	return &replay{
+		Buffer: make([]string, 0),
+		Hashes: make(map[string]bool),
+		Lock:   &sync.Mutex{},
+	}
+}
+
+// remember - Keeps hash of block replayed, so that same block, if
+// received as live data, isn't delivered again
+func (r *replay) remember(hash string) {
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	r.Hashes[hash] = true
+}
+
+// replayed - Checks whether block with given hash was replayed
+func (r *replay) replayed(hash string) bool {
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	return r.Hashes[hash]
+}
+
+// done - Marks replay as caught up, so that buffered live data can be flushed
+func (r *replay) done() {
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	r.Done = true
+}
+
+// isLive - Checks whether request has been switched to live data
+func (r *replay) isLive() bool {
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	return r.Live
+}
+
+// buffer - Keeps live data aside, until replay is caught up
+func (r *replay) buffer(msg string) {
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	r.Buffer = append(r.Buffer, msg)
+}
+
+// goLive - If replay is caught up, switches request to live data, returning
+// live data buffered till now, which is to be delivered first
+func (r *replay) goLive() ([]string, bool) {
+	r.Lock.Lock()
+	defer r.Lock.Unlock()
+
+	if !r.Done || r.Live {
+		return nil, false
+	}
+
+	buffered := r.Buffer
+
+	r.Live = true
+	r.Buffer = nil
+
+	return buffered, true
+}
+
+// pick - Finds subscription request, to which published data is to be delivered,
+// among those matching it, while data belonging to block already replayed for
+// any of them is dropped, because client has already received it once
+//
+// If only requests being replayed are matching, data is buffered with one of
+// them, to be delivered once it's caught up
+func pick(requests map[string]*SubscriptionRequest, match func(*SubscriptionRequest) bool, blockHash string, msg string) *SubscriptionRequest {
+
+	var live, replaying *SubscriptionRequest
+
+	for _, v := range requests {
+
+		if !match(v) {
+			continue
+		}
+
+		if v.replay == nil {
+
+			if live == nil {
+				live = v
+			}
+
+			continue
+
+		}
+
+		if v.replay.replayed(blockHash) {
+			return nil
+		}
+
+		if v.replay.isLive() {
+
+			if live == nil {
+				live = v
+			}
+
+			continue
+
+		}
+
+		if replaying == nil {
+			replaying = v
+		}
+
+	}
+
+	if live == nil && replaying != nil {
+		replaying.replay.buffer(msg)
+	}
+
+	return live
+
+}
+
+// flush - Switches requests, which are done with replaying, to live data, while
+// delivering live data buffered with them, before anything received afterwards
+//
+// To be invoked from listener, so that ordering is preserved
+func flush(lock *sync.RWMutex, requests map[string]*SubscriptionRequest, send func(string)) {
+
+	// -- Shared memory being read from concurrently
+	// running thread of execution, with lock
+	lock.RLock()
+
+	replays := make([]*replay, 0)
+	for _, v := range requests {
+
+		if v.replay != nil {
+			replays = append(replays, v.replay)
+		}
+
+	}
+
+	lock.RUnlock()
+	// -- Shared memory reading done, lock released
+
+	for _, v := range replays {
+
+		buffered, ok := v.goLive()
+		if !ok {
+			continue
+		}
+
+		for _, msg := range buffered {
+			send(msg)
+		}
+
+	}
+
+}
+
+// isSubscribed - Checks whether this very request is still subscribed
+func (s *SubscriptionManager) isSubscribed(req *SubscriptionRequest) bool {
+
+	s.TopicLock.RLock()
+	defer s.TopicLock.RUnlock()
+
+	v, ok := s.Topics[req.Topic()][req.Name]
+	return ok && v == req
+
+}
+
+// replayable - Data of block, belonging to topic of request, in same form as
+// it's published, so that it's delivered same way as live data
+func (s *SubscriptionManager) replayable(req *SubscriptionRequest, block *d.Block) []string {
+
+	marshalled := make([]string, 0)
+
+	switch req.Topic() {
+
+	case "block":
+
+		if _m, err := block.MarshalBinary(); err == nil {
+			marshalled = append(marshalled, string(_m))
+		}
+
+	case "transaction":
+
+		txs := db.GetTransactionsByBlockHash(s.DB, common.HexToHash(block.Hash))
+		if txs == nil {
+			return nil
+		}
+
+		for _, v := range txs.Transactions {
+
+			if _m, err := v.MarshalBinary(); err == nil {
+				marshalled = append(marshalled, string(_m))
+			}
+
+		}
+
+	case "event":
+
+		events := db.GetEventsByBlockHash(s.DB, common.HexToHash(block.Hash))
+		if events == nil {
+			return nil
+		}
+
+		sort.Slice(events.Events, func(i, j int) bool {
+			return events.Events[i].Index < events.Events[j].Index
+		})
+
+		for _, v := range events.Events {
+
+			if _m, err := v.MarshalBinary(); err == nil {
+				marshalled = append(marshalled, string(_m))
+			}
+
+		}
+
+	}
+
+	return marshalled
+
+}
+
+// replay - Delivers data matching subscription request, from database, block by
+// block, starting from block asked for, until it's caught up with latest block
+// persisted, when request is switched to live data
+//
+// Primary is queried, not read replicas, because blocks being persisted while
+// catching up, which might also be received as live data, must be seen
+func (s *SubscriptionManager) replay(consumer Replayer, req *SubscriptionRequest) {
+
+	// Buffered live data isn't to be held back, even if replay fails
+	defer req.replay.done()
+
+	from := *req.FromBlock
+	depth := cfg.GetMaxReorgDepth()
+
+	step := cfg.GetBlockNumberRange()
+	if step == 0 {
+		step = 1
+	}
+
+	for {
+
+		// Client has unsubscribed or connection is closed
+		if !s.isSubscribed(req) {
+			return
+		}
+
+		latest := db.GetCurrentBlockNumber(s.DB)
+		if from > latest {
+			break
+		}
+
+		to := from + step - 1
+		if to > latest {
+			to = latest
+		}
+
+		blocks := db.GetBlocksByNumberRange(s.DB, from, to)
+		if blocks == nil {
+
+			log.Printf("[!] Failed to replay blocks %d - %d for `%s`\n", from, to, req.Name)
+			consumer.SendData(&SubscriptionResponse{
+				Code:    0,
+				Message: fmt.Sprintf("Failed to replay `%s`", req.Topic()),
+			})
+			return
+
+		}
+
+		for _, b := range blocks.Blocks {
+
+			// Only blocks near tip can also be received as live data, so
+			// only those need to be remembered
+			if b.Number+depth >= latest {
+				req.replay.remember(b.Hash)
+			}
+
+			for _, msg := range s.replayable(req, b) {
+
+				if !consumer.Replay(req, msg) {
+					return
+				}
+
+			}
+
+		}
+
+		from = to + 1
+
+	}
+
+	consumer.SendData(&SubscriptionResponse{
+		Code:    1,
+		Message: fmt.Sprintf("Replayed `%s` till block %d", req.Topic(), from-1),
+	})
+
+}
@@ -1,12 +1,14 @@
 package pubsub
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
 	"gorm.io/gorm"
@@ -14,10 +16,17 @@ import (
 
 // SubscriptionRequest - Real time data subscription/ unsubscription request
 // needs to be sent in this form, from client application
+//
+// When `fromBlock` is provided, data starting from that block is first
+// replayed from database, before switching to live data
 type SubscriptionRequest struct {
-	Name   string `json:"name"`
-	Type   string `json:"type"`
-	APIKey string `json:"apiKey"`
+	Name      string  `json:"name"`
+	Type      string  `json:"type"`
+	APIKey    string  `json:"apiKey"`
+	Decode    bool    `json:"decode"`
+	FromBlock *uint64 `json:"fromBlock,omitempty"`
+
+	replay *replay
 }
 
 // GetUserFromAPIKey - Given API Key, which is being used for subscribing to
@@ -39,7 +48,7 @@ func (s *SubscriptionRequest) IsUnderRateLimit(db *gorm.DB, address common.Addre
 
 // GetRegex - Returns regex to be used for validating subscription request
 func (s *SubscriptionRequest) GetRegex() *regexp.Regexp {
-	pattern, err := regexp.Compile("^(block|(transaction(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)|(event(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*))?)?)?)?)?))$")
+	pattern, err := regexp.Compile("^(block|reorg|(transaction(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)|(event(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*))?)?)?)?)?)|(transfer(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)?)|(pending(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?))$")
 	if err != nil {
 		log.Printf("[!] Failed to parse regex pattern : %s\n", err.Error())
 		return nil
@@ -49,12 +58,16 @@ func (s *SubscriptionRequest) GetRegex() *regexp.Regexp {
 }
 
 // Topic - Get main topic name to which this client is subscribing to
-// i.e. {block, transaction, event}
+// i.e. {block, transaction, event, reorg, transfer, pending}
 func (s *SubscriptionRequest) Topic() string {
 	if strings.HasPrefix(s.Name, "block") {
 		return "block"
 	}
 
+	if strings.HasPrefix(s.Name, "reorg") {
+		return "reorg"
+	}
+
 	if strings.HasPrefix(s.Name, "transaction") {
 		return "transaction"
 	}
@@ -63,6 +76,14 @@ func (s *SubscriptionRequest) Topic() string {
 		return "event"
 	}
 
+	if strings.HasPrefix(s.Name, "transfer") {
+		return "transfer"
+	}
+
+	if strings.HasPrefix(s.Name, "pending") {
+		return "pending"
+	}
+
 	return ""
 }
 
@@ -157,6 +178,92 @@ func (s *SubscriptionRequest) GetTransactionFilters() []string {
 	return []string{matches[4], matches[6]}
 }
 
+// GetTokenTransferFilters - Extracts token contract, from & to account present
+// in token transfer subscription request
+//
+// Pattern looks like : `transfer/<token>/<from>/<to>`
+//
+// these could possibly be empty/ * / 0x...
+func (s *SubscriptionRequest) GetTokenTransferFilters() []string {
+	pattern := s.GetRegex()
+	if pattern == nil {
+		return nil
+	}
+
+	matches := pattern.FindStringSubmatch(s.Name)
+	return []string{matches[20], matches[22], matches[24]}
+}
+
+// DoesMatchWithPublishedTokenTransferData - All `transfer` topic listeners are going to
+// get notified for any token transfer, but they'll only deliver those to client application,
+// which are matching token, from & to filters, provided when subscribing
+func (s *SubscriptionRequest) DoesMatchWithPublishedTokenTransferData(transfer *data.TokenTransfer) bool {
+
+	// Matches single filter value against respective
+	// field of published token transfer
+	matchField := func(filter string, field string) bool {
+		switch filter {
+		// match with any address
+		case "", "*":
+			return true
+		// match with specific address
+		default:
+			return CheckSimilarity(filter, field)
+		}
+	}
+
+	filters := s.GetTokenTransferFilters()
+	if filters == nil {
+		return false
+	}
+
+	return matchField(filters[0], transfer.Token) && matchField(filters[1], transfer.From) && matchField(filters[2], transfer.To)
+
+}
+
+// GetPendingTransactionFilters - Extracts from & to account present in
+// pending tx subscription request
+//
+// Pattern looks like : `pending/<from>/<to>`
+//
+// these could possibly be empty/ * / 0x...
+func (s *SubscriptionRequest) GetPendingTransactionFilters() []string {
+	pattern := s.GetRegex()
+	if pattern == nil {
+		return nil
+	}
+
+	matches := pattern.FindStringSubmatch(s.Name)
+	return []string{matches[27], matches[29]}
+}
+
+// DoesMatchWithPublishedPendingTransactionData - All `pending` topic listeners are going to
+// get notified for any pending tx & its follow ups, but they'll only deliver those to client
+// application, which are matching from & to filters, provided when subscribing
+func (s *SubscriptionRequest) DoesMatchWithPublishedPendingTransactionData(tx *data.PendingTransaction) bool {
+
+	// Matches single filter value against respective
+	// field of published pending tx
+	matchField := func(filter string, field string) bool {
+		switch filter {
+		// match with any address
+		case "", "*":
+			return true
+		// match with specific address
+		default:
+			return CheckSimilarity(filter, field)
+		}
+	}
+
+	filters := s.GetPendingTransactionFilters()
+	if filters == nil {
+		return false
+	}
+
+	return matchField(filters[0], tx.From) && matchField(filters[1], tx.To)
+
+}
+
 // CheckSimilarity - Performing case insensitive matching between two
 // strings
 func CheckSimilarity(first string, second string) bool {
@@ -219,6 +326,72 @@ func (s *SubscriptionRequest) DoesMatchWithPublishedTransactionData(tx *data.Tra
 	return status
 }
 
+// DoesMatchWithPublishedData - Checks whether data published on topic of this
+// request, as received from broker, is what client is interested in, by decoding
+// only those fields, which are matched against filters present in request
+//
+// Data published on `block` & `reorg` topics always matches
+func (s *SubscriptionRequest) DoesMatchWithPublishedData(msg string) bool {
+
+	switch s.Topic() {
+
+	case "transaction":
+
+		var tx struct {
+			From string `json:"from"`
+			To   string `json:"to"`
+		}
+
+		if err := json.Unmarshal([]byte(msg), &tx); err != nil {
+			log.Printf("[!] Failed to decode published transaction data to JSON : %s\n", err.Error())
+			return false
+		}
+
+		return s.DoesMatchWithPublishedTransactionData(&data.Transaction{From: tx.From, To: tx.To})
+
+	case "event":
+
+		var event struct {
+			Origin string   `json:"origin"`
+			Topics []string `json:"topics"`
+		}
+
+		if err := json.Unmarshal([]byte(msg), &event); err != nil {
+			log.Printf("[!] Failed to decode published event data to JSON : %s\n", err.Error())
+			return false
+		}
+
+		return s.DoesMatchWithPublishedEventData(&data.Event{Origin: event.Origin, Topics: event.Topics})
+
+	case "transfer":
+
+		var transfer data.TokenTransfer
+
+		if err := json.Unmarshal([]byte(msg), &transfer); err != nil {
+			log.Printf("[!] Failed to decode published token transfer data to JSON : %s\n", err.Error())
+			return false
+		}
+
+		return s.DoesMatchWithPublishedTokenTransferData(&transfer)
+
+	case "pending":
+
+		var tx data.PendingTransaction
+
+		if err := json.Unmarshal([]byte(msg), &tx); err != nil {
+			log.Printf("[!] Failed to decode published pending tx data to JSON : %s\n", err.Error())
+			return false
+		}
+
+		return s.DoesMatchWithPublishedPendingTransactionData(&tx)
+
+	default:
+		return true
+
+	}
+
+}
+
 // IsValidTopic - Checks whether topic to which client application is trying to
 // subscribe to is valid one or not
 func (s *SubscriptionRequest) IsValidTopic() bool {
@@ -230,6 +403,26 @@ func (s *SubscriptionRequest) IsValidTopic() bool {
 	return pattern.MatchString(s.Name)
 }
 
+// CanReplay - Checks whether data asked to be replayed, starting from some block,
+// can be replayed, which is possible only for `block`, `transaction` & `event`
+// topics, when `ette` is persisting data
+func (s *SubscriptionRequest) CanReplay() bool {
+	if s.FromBlock == nil {
+		return true
+	}
+
+	if !cfg.IsHistorical() {
+		return false
+	}
+
+	switch s.Topic() {
+	case "block", "transaction", "event":
+		return true
+	default:
+		return false
+	}
+}
+
 // Validate - Validates request from client for subscription/ unsubscription
 func (s *SubscriptionRequest) Validate(pubsubManager *SubscriptionManager) bool {
 
@@ -264,7 +457,7 @@ func (s *SubscriptionRequest) Validate(pubsubManager *SubscriptionManager) bool
 
 	switch s.Type {
 	case "subscribe":
-		validated = s.IsValidTopic() && !checkEntryInAssociativeArray()
+		validated = s.IsValidTopic() && s.CanReplay() && !checkEntryInAssociativeArray()
 	case "unsubscribe":
 		validated = s.IsValidTopic() && checkEntryInAssociativeArray()
 	default:
@@ -1,19 +1,11 @@
 package pubsub
 
 import (
-	"context"
 	"encoding/hex"
 	"encoding/json"
 	"log"
-	"sync"
-	"time"
 
-	"github.com/go-redis/redis/v8"
-	"github.com/gorilla/websocket"
-	"github.com/itzmeanjan/ette/app/data"
 	d "github.com/itzmeanjan/ette/app/data"
-	"github.com/itzmeanjan/ette/app/db"
-	"gorm.io/gorm"
 )
 
 // TransactionConsumer - Transaction consumer info holder struct, to be used
@@ -22,59 +14,35 @@ import (
 //
 // If yes, also deliver data to client application, connected over websocket
 type TransactionConsumer struct {
-	Client     *redis.Client
-	Requests   map[string]*SubscriptionRequest
-	Connection *websocket.Conn
-	PubSub     *redis.PubSub
-	DB         *gorm.DB
-	ConnLock   *sync.Mutex
-	TopicLock  *sync.RWMutex
-	Counter    *data.SendReceiveCounter
+	topicConsumer
 }
 
-// Subscribe - Subscribe to `transaction` topic, under which all transaction related data to be published
-func (t *TransactionConsumer) Subscribe() {
-	t.PubSub = t.Client.Subscribe(context.Background(), "transaction")
-}
-
-// Listen - Listener function, which keeps looping in infinite loop
-// and reads data from subcribed channel, which also gets delivered to client application
+// Listen - Listener function, which keeps receiving data published on `transaction`
+// topic, in order, until unsubscribed, which also gets delivered to client
+// application
+//
+// Live data buffered with requests, which are done with replaying, is
+// delivered before anything received afterwards
 func (t *TransactionConsumer) Listen() {
-
-	for {
-
-		msg, err := t.PubSub.ReceiveTimeout(context.Background(), time.Second)
-		if err != nil {
-			continue
-		}
-
-		switch m := msg.(type) {
-
-		case *redis.Subscription:
-
-			// Pubsub broker informed we've been unsubscribed from
-			// this topic
-			if m.Kind == "unsubscribe" {
-				return
-			}
-
-			t.SendData(&SubscriptionResponse{
-				Code:    1,
-				Message: "Subscribed to `transaction`",
-			})
-
-		case *redis.Message:
-			t.Send(m.Payload)
-
-		}
-
-	}
-
+	t.consume(func() { flush(t.TopicLock, t.Requests, t.Send) }, t.Send)
 }
 
 // Send - Tries to deliver subscribed transaction data to client application
 // connected over websocket
 func (t *TransactionConsumer) Send(msg string) {
+	t.send(msg, nil)
+}
+
+// Replay - Delivers transaction data, read from database, to client application,
+// for given subscription request, which is being replayed
+func (t *TransactionConsumer) Replay(req *SubscriptionRequest, msg string) bool {
+	return t.send(msg, req)
+}
+
+// send - Delivers transaction data for subscription request, which is picked among
+// matching ones, unless target request is given, returning false only when
+// client can't be delivered anymore
+func (t *TransactionConsumer) send(msg string, target *SubscriptionRequest) bool {
 
 	// Creating this temporary struct definition here, because
 	// while unmarshalling JSON it was failing in `{ Data: []byte }`
@@ -95,13 +63,24 @@ func (t *TransactionConsumer) Send(msg string) {
 		Nonce     uint64 `json:"nonce"`
 		State     uint64 `json:"state"`
 		BlockHash string `json:"blockHash"`
+
+		Type                 uint8           `json:"type"`
+		MaxFeePerGas         string          `json:"maxFeePerGas"`
+		MaxPriorityFeePerGas string          `json:"maxPriorityFeePerGas"`
+		EffectiveGasPrice    string          `json:"effectiveGasPrice"`
+		GasUsed              uint64          `json:"gasUsed"`
+		AccessList           json.RawMessage `json:"accessList"`
+		BlobGas              uint64          `json:"blobGas"`
+		MaxFeePerBlobGas     string          `json:"maxFeePerBlobGas"`
+		BlobGasPrice         string          `json:"blobGasPrice"`
+		BlobHashes           []string        `json:"blobHashes"`
 	}
 
 	_msg := []byte(msg)
 
 	if err := json.Unmarshal(_msg, &transaction); err != nil {
 		log.Printf("[!] Failed to decode published transaction data to JSON : %s\n", err.Error())
-		return
+		return true
 	}
 
 	data := make([]byte, 0)
@@ -116,7 +95,7 @@ func (t *TransactionConsumer) Send(msg string) {
 
 	if err != nil {
 		log.Printf("[!] Failed to decode data field of transaction : %s\n", err.Error())
-		return
+		return true
 	}
 
 	tx := &d.Transaction{
@@ -132,171 +111,45 @@ func (t *TransactionConsumer) Send(msg string) {
 		Nonce:     transaction.Nonce,
 		State:     transaction.State,
 		BlockHash: transaction.BlockHash,
-	}
-
-	var request *SubscriptionRequest
-
-	// -- Shared memory being read from concurrently
-	// running thread of execution, with lock
-	t.TopicLock.RLock()
-
-	for _, v := range t.Requests {
-
-		if v.DoesMatchWithPublishedTransactionData(tx) {
-			request = v
-			break
-		}
-
-	}
-
-	t.TopicLock.RUnlock()
-	// -- Lock released, shared memory reading done
 
-	// Can't proceed with this anymore, because failed to find
-	// respective subscription request
-	if request == nil {
-		return
+		Type:                 transaction.Type,
+		MaxFeePerGas:         transaction.MaxFeePerGas,
+		MaxPriorityFeePerGas: transaction.MaxPriorityFeePerGas,
+		EffectiveGasPrice:    transaction.EffectiveGasPrice,
+		GasUsed:              transaction.GasUsed,
+		AccessList:           transaction.AccessList,
+		BlobGas:              transaction.BlobGas,
+		MaxFeePerBlobGas:     transaction.MaxFeePerBlobGas,
+		BlobGasPrice:         transaction.BlobGasPrice,
+		BlobHashes:           transaction.BlobHashes,
 	}
 
-	user := db.GetUserFromAPIKey(t.DB, request.APIKey)
-	if user == nil {
-
-		// -- Critical section of code begins
-		//
-		// Attempting to write to a network resource,
-		// shared among multiple go routines
-		t.ConnLock.Lock()
-
-		if err := t.Connection.WriteJSON(&SubscriptionResponse{
-			Code:    0,
-			Message: "Bad API Key",
-		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
-		}
-
-		t.ConnLock.Unlock()
-		// -- ends here
-
-		// Because we're writing to socket
-		t.Counter.IncrementSend(1)
-		return
-
+	// Matching with target request, when replaying
+	if target != nil && !target.DoesMatchWithPublishedTransactionData(tx) {
+		return true
 	}
 
-	if !user.Enabled {
-
-		// -- Critical section of code begins
-		//
-		// Attempting to write to a network resource,
-		// shared among multiple go routines
-		t.ConnLock.Lock()
+	request := target
 
-		if err := t.Connection.WriteJSON(&SubscriptionResponse{
-			Code:    0,
-			Message: "Bad API Key",
-		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
-		}
-
-		t.ConnLock.Unlock()
-		// -- ends here
-
-		// Because we're writing to socket
-		t.Counter.IncrementSend(1)
-		return
-
-	}
-
-	// Don't deliver data & close underlying connection
-	// if client has crossed it's allowed data delivery limit
-	if !db.IsUnderRateLimit(t.DB, user.Address) {
-
-		// -- Critical section of code begins
-		//
-		// Attempting to write to a network resource,
-		// shared among multiple go routines
-		t.ConnLock.Lock()
-
-		if err := t.Connection.WriteJSON(&SubscriptionResponse{
-			Code:    0,
-			Message: "Crossed Allowed Rate Limit",
-		}); err != nil {
-			log.Printf("[!] Failed to deliver rate limit crossed message to client : %s\n", err.Error())
-		}
-
-		t.ConnLock.Unlock()
-		// -- ends here
-
-		// Because we're writing to socket
-		t.Counter.IncrementSend(1)
-		return
-
-	}
-
-	if t.SendData(&transaction) {
-		db.PutDataDeliveryInfo(t.DB, user.Address, "/v1/ws/transaction", uint64(len(msg)))
-	}
-
-}
-
-// SendData - Sending message to client application, connected over websocket
-//
-// If failed, we're going to remove subscription & close websocket
-// connection ( connection might be already closed though )
-func (t *TransactionConsumer) SendData(data interface{}) bool {
-
-	// -- Critical section of code begins
-	//
-	// Attempting to write to a network resource,
-	// shared among multiple go routines
-	t.ConnLock.Lock()
-	defer t.ConnLock.Unlock()
-
-	if err := t.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `transaction` data to client : %s\n", err.Error())
-		return false
-	}
-
-	// Because we're writing to socket
-	t.Counter.IncrementSend(1)
-
-	return true
+	if request == nil {
 
-}
+		// -- Shared memory being read from concurrently
+		// running thread of execution, with lock
+		t.TopicLock.RLock()
 
-// Unsubscribe - Unsubscribe from transactions pubsub topic, which client has subscribed to
-func (t *TransactionConsumer) Unsubscribe() {
+		request = pick(t.Requests, func(r *SubscriptionRequest) bool { return r.DoesMatchWithPublishedTransactionData(tx) }, tx.BlockHash, msg)
 
-	if t.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `transaction` topic\n")
-		return
-	}
+		t.TopicLock.RUnlock()
+		// -- Shared memory reading done, lock released
 
-	if err := t.PubSub.Unsubscribe(context.Background(), "transaction"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `transaction` topic : %s\n", err.Error())
-		return
 	}
 
-	resp := &SubscriptionResponse{
-		Code:    1,
-		Message: "Unsubscribed from `transaction`",
-	}
-
-	// -- Critical section of code begins
-	//
-	// Attempting to write to a network resource,
-	// shared among multiple go routines
-	t.ConnLock.Lock()
-	defer t.ConnLock.Unlock()
-
-	if err := t.Connection.WriteJSON(resp); err != nil {
-
-		log.Printf("[!] Failed to deliver `transaction` unsubscription confirmation to client : %s\n", err.Error())
-		return
-
+	// Can't proceed with this anymore, because failed to find
+	// respective subscription request
+	if request == nil {
+		return true
 	}
 
-	// Because we're writing to socket
-	t.Counter.IncrementSend(1)
+	return t.deliver(request, &transaction, len(msg))
 
 }
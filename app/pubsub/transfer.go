@@ -0,0 +1,48 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"log"
+
+	d "github.com/itzmeanjan/ette/app/data"
+)
+
+// TransferConsumer - Token transfer consumption to be managed by this struct, when new websocket
+// connection requests for receiving token transfer data, it'll create this struct, with necessary pieces
+// of information, which is to be required when delivering data & checking whether this connection
+// has really requested notification for this token transfer or not
+type TransferConsumer struct {
+	topicConsumer
+}
+
+// Listen - Listener function, which keeps receiving data published on `transfer`
+// topic, in order, until unsubscribed, which also gets delivered to client
+// application
+func (t *TransferConsumer) Listen() {
+	t.consume(nil, t.Send)
+}
+
+// Send - Sending token transfer data to client application, which has subscribed to this
+// token transfer & connected over websocket
+func (t *TransferConsumer) Send(msg string) {
+
+	var transfer d.TokenTransfer
+
+	_msg := []byte(msg)
+
+	if err := json.Unmarshal(_msg, &transfer); err != nil {
+		log.Printf("[!] Failed to decode published token transfer data to JSON : %s\n", err.Error())
+		return
+	}
+
+	request := t.first(func(r *SubscriptionRequest) bool { return r.DoesMatchWithPublishedTokenTransferData(&transfer) })
+
+	// Can't proceed with this anymore, because failed to find
+	// respective subscription request
+	if request == nil {
+		return
+	}
+
+	t.deliver(request, &transfer, len(msg))
+
+}
@@ -3,6 +3,7 @@ package queue
 import (
 	"context"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/itzmeanjan/ette/app/config"
@@ -18,6 +19,7 @@ type Block struct {
 	UnconfirmedDone     bool // 3. Done with processing
 	ConfirmedProgress   bool // 4. Attempting confirm whether chain reorg happened or not
 	ConfirmedDone       bool // 5. Done with bringing latest changes ✅
+	Requeued            bool // Requeued while being processed, kept only in memory
 	LastAttempted       time.Time
 	Delay               time.Duration
 }
@@ -45,12 +47,55 @@ func (b *Block) CanAttempt() bool {
 	return time.Now().UTC().After(b.LastAttempted.Add(b.Delay))
 }
 
+// State - Which phase of processing block is in, as reported to admin
+func (b *Block) State() string {
+
+	switch {
+
+	case b.UnconfirmedProgress:
+		return "unconfirmed_progress"
+	case !b.UnconfirmedDone:
+		return "unconfirmed_waiting"
+	case b.ConfirmedProgress:
+		return "confirmed_progress"
+	case !b.ConfirmedDone:
+		return "confirmed_waiting"
+	default:
+		return "done"
+
+	}
+
+}
+
+// Superseded - Whether worker reporting back about this block was processing
+// it before it got requeued, in that case, report is to be ignored & block is
+// put back in waiting phase, so that it gets processed again from scratch
+func (b *Block) Superseded() bool {
+
+	if !b.Requeued {
+		return false
+	}
+
+	b.Requeued = false
+	b.UnconfirmedProgress = false
+	b.ConfirmedProgress = false
+
+	return true
+
+}
+
+// NextAttempt - When this block can be attempted to be processed next
+func (b *Block) NextAttempt() time.Time {
+	return b.LastAttempted.Add(b.Delay)
+}
+
 // Request - Any request to be placed into
 // queue's channels in this form, so that client
 // can also receive response/ confirmation over channel
 // that they specify
 type Request struct {
 	BlockNumber  uint64
+	Silent       bool // Only for requeueing, whether block is to be processed without publishing
 	ResponseChan chan bool
 }
 
@@ -74,6 +119,23 @@ type Stat struct {
 	ResponseChan chan StatResponse
 }
 
+// List - Clients can ask for state of all blocks
+// present in queue currently
+type List struct {
+	ResponseChan chan []*QueuedBlock
+}
+
+// QueuedBlock - State of single block present in queue,
+// to be responded back to client in this form
+type QueuedBlock struct {
+	Number        uint64    `json:"number"`
+	State         string    `json:"state"`
+	Published     bool      `json:"published"`
+	LastAttempted time.Time `json:"lastAttempted"`
+	Delay         string    `json:"delay"`
+	NextAttempt   time.Time `json:"nextAttempt"`
+}
+
 // StatResponse - Statistics of queue to be
 // responded back to client in this form
 type StatResponse struct {
@@ -95,6 +157,7 @@ type BlockProcessorQueue struct {
 	LatestBlock           uint64
 	Total                 uint64
 	PutChan               chan Request
+	RequeueChan           chan Request
 	CanPublishChan        chan Request
 	PublishedChan         chan Request
 	InsertedChan          chan Request
@@ -103,22 +166,35 @@ type BlockProcessorQueue struct {
 	ConfirmedFailedChan   chan Request
 	ConfirmedDoneChan     chan Request
 	StatChan              chan Stat
+	ListChan              chan List
+	RetryChan             chan Request
+	ResetDelayChan        chan Request
+	DropChan              chan Request
 	LatestChan            chan Update
 	UnconfirmedNextChan   chan Next
 	ConfirmedNextChan     chan Next
+	store                 Store
+	dirty                 map[uint64]struct{}
+	deleted               map[uint64]struct{}
+	saveChan              chan *changes
+	done                  chan struct{}
 }
 
 // New - Getting new instance of queue, to be
 // invoked during setting up application
-func New(startingWith uint64) *BlockProcessorQueue {
+//
+// If durable store is provided, queue state is reloaded from it & kept
+// persisted there, so that restarts resume from where it was left
+func New(startingWith uint64, store Store) *BlockProcessorQueue {
 
-	return &BlockProcessorQueue{
+	b := &BlockProcessorQueue{
 		Blocks:                make(map[uint64]*Block),
 		StartedWith:           startingWith,
 		TotalInserted:         0,
 		LatestBlock:           0,
 		Total:                 0,
 		PutChan:               make(chan Request, 128),
+		RequeueChan:           make(chan Request, 128),
 		CanPublishChan:        make(chan Request, 128),
 		PublishedChan:         make(chan Request, 128),
 		InsertedChan:          make(chan Request, 128),
@@ -127,11 +203,23 @@ func New(startingWith uint64) *BlockProcessorQueue {
 		ConfirmedFailedChan:   make(chan Request, 128),
 		ConfirmedDoneChan:     make(chan Request, 128),
 		StatChan:              make(chan Stat, 1),
+		ListChan:              make(chan List, 1),
+		RetryChan:             make(chan Request, 1),
+		ResetDelayChan:        make(chan Request, 1),
+		DropChan:              make(chan Request, 1),
 		LatestChan:            make(chan Update, 1),
 		UnconfirmedNextChan:   make(chan Next, 1),
 		ConfirmedNextChan:     make(chan Next, 1),
+		store:                 store,
+		dirty:                 make(map[uint64]struct{}),
+		deleted:               make(map[uint64]struct{}),
+		saveChan:              make(chan *changes, 1),
+		done:                  make(chan struct{}),
 	}
 
+	b.restore()
+	return b
+
 }
 
 // Put - Client is supposed to be invoking this method
@@ -155,6 +243,42 @@ func (b *BlockProcessorQueue) Put(block uint64) bool {
 
 }
 
+// Requeue - Puts block back into processing queue, while wiping out
+// whatever history it had, so that it gets processed ( & published ) again
+// from scratch, by retry queue manager
+//
+// To be invoked when some block is known to be changed, due to chain reorganization
+// or it was found to be missing from database
+func (b *BlockProcessorQueue) Requeue(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.RequeueChan <- req
+	return <-resp
+
+}
+
+// RequeueSilently - Same as `Requeue`, but block is only processed again,
+// never published, because it's old block being repaired & real-time
+// subscribers must not receive it as if it's new
+func (b *BlockProcessorQueue) RequeueSilently(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		Silent:       true,
+		ResponseChan: resp,
+	}
+
+	b.RequeueChan <- req
+	return <-resp
+
+}
+
 // CanPublish - Before any client attempts to publish any block
 // on Pub/Sub topic, they're supposed to be invoking this method
 // to check whether they're eligible of publishing or not
@@ -274,6 +398,94 @@ func (b *BlockProcessorQueue) Stat() StatResponse {
 
 }
 
+// List - Returns state of all blocks present in queue, in ascending
+// order of block number
+func (b *BlockProcessorQueue) List() []*QueuedBlock {
+
+	resp := make(chan []*QueuedBlock)
+	req := List{ResponseChan: resp}
+
+	b.ListChan <- req
+	return <-resp
+
+}
+
+// Retry - Makes block, which is waiting to be processed, eligible for
+// processing right now, without waiting for its delay to elapse
+//
+// Blocks being processed currently can't be retried
+func (b *BlockProcessorQueue) Retry(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.RetryChan <- req
+	return <-resp
+
+}
+
+// ResetDelay - Resets backoff of block, so that next time it fails,
+// it starts waiting from 1 second again
+func (b *BlockProcessorQueue) ResetDelay(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.ResetDelayChan <- req
+	return <-resp
+
+}
+
+// Drop - Removes block from queue, so that it's not attempted to be
+// processed anymore, unless it gets put into queue again
+func (b *BlockProcessorQueue) Drop(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.DropChan <- req
+	return <-resp
+
+}
+
+// RequeueRange - Silently requeues all blocks in [from, to] range, returning
+// how many of them were requeued
+func (b *BlockProcessorQueue) RequeueRange(from uint64, to uint64) uint64 {
+
+	var count uint64
+
+	for i := from; i <= to; i++ {
+
+		if b.RequeueSilently(i) {
+			count++
+		}
+
+		// Avoiding overflow, when `to` is max possible block number
+		if i == to {
+			break
+		}
+
+	}
+
+	return count
+
+}
+
+// Wait - Blocks until queue has stopped, after its context got cancelled,
+// along with persisting its final state
+func (b *BlockProcessorQueue) Wait() {
+	<-b.done
+}
+
 // Latest - Block head subscriber will update queue manager
 // that latest block seen is updated
 func (b *BlockProcessorQueue) Latest(num uint64) bool {
@@ -334,12 +546,27 @@ func (b *BlockProcessorQueue) CanBeConfirmed(num uint64) bool {
 // Start - You're supposed to be starting this method as an
 // independent go routine, with will listen on multiple channels
 // & respond back over provided channel ( by client )
+//
+// When context is cancelled, it persists final queue state & stops, which
+// can be waited for, using `Wait`
 func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
+	if b.store == nil {
+		defer close(b.done)
+	} else {
+		go b.save()
+	}
+
 	for {
 		select {
 
 		case <-ctx.Done():
+
+			if b.store != nil {
+				b.flush(true)
+				close(b.saveChan)
+			}
+
 			return
 
 		case req := <-b.PutChan:
@@ -358,6 +585,44 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				LastAttempted:       time.Now().UTC(),
 				Delay:               time.Duration(1) * time.Second,
 			}
+			b.touch(req.BlockNumber)
+			req.ResponseChan <- true
+
+		case req := <-b.RequeueChan:
+
+			// Not yet seen block gets entered in waiting phase, so that
+			// retry queue manager can pick it up, while already seen one
+			// gets its history wiped out
+			//
+			// Silently requeued block is marked as published up front, so
+			// that nobody attempts to publish it
+			block, ok := b.Blocks[req.BlockNumber]
+			if !ok {
+
+				b.Blocks[req.BlockNumber] = &Block{
+					Published:     req.Silent,
+					LastAttempted: time.Now().UTC(),
+					Delay:         time.Duration(1) * time.Second,
+				}
+				b.touch(req.BlockNumber)
+				req.ResponseChan <- true
+				break
+
+			}
+
+			// Worker still processing this block is going to report back
+			// about older version of it, which is to be ignored, while block
+			// is kept in progress till then, so that it's not picked up twice
+			if block.UnconfirmedProgress || block.ConfirmedProgress {
+				block.Requeued = true
+			}
+
+			block.Published = req.Silent
+			block.UnconfirmedDone = false
+			block.ConfirmedDone = false
+			block.ResetDelay()
+			b.touch(req.BlockNumber)
+
 			req.ResponseChan <- true
 
 		case req := <-b.CanPublishChan:
@@ -384,7 +649,11 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
-			block.Published = true
+			if !block.Requeued {
+				block.Published = true
+				b.touch(req.BlockNumber)
+			}
+
 			req.ResponseChan <- true
 
 		case req := <-b.InsertedChan:
@@ -406,8 +675,15 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
+			if block.Superseded() {
+				b.touch(req.BlockNumber)
+				req.ResponseChan <- false
+				break
+			}
+
 			block.UnconfirmedProgress = false
 			block.SetDelay()
+			b.touch(req.BlockNumber)
 
 			req.ResponseChan <- true
 
@@ -419,10 +695,16 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
+			if block.Superseded() {
+				b.touch(req.BlockNumber)
+				req.ResponseChan <- false
+				break
+			}
+
 			block.UnconfirmedProgress = false
 			block.UnconfirmedDone = true
 
-			if config.Get("EtteMode") == "1" || config.Get("EtteMode") == "3" {
+			if config.IsHistorical() {
 				block.ConfirmedDone = b.CanBeConfirmed(req.BlockNumber)
 			} else {
 				block.ConfirmedDone = true // No need to attain this, because we're not putting anything in DB
@@ -430,6 +712,7 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 			block.ResetDelay()
 			block.SetLastAttempted()
+			b.touch(req.BlockNumber)
 
 			req.ResponseChan <- true
 
@@ -441,8 +724,15 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
+			if block.Superseded() {
+				b.touch(req.BlockNumber)
+				req.ResponseChan <- false
+				break
+			}
+
 			block.ConfirmedProgress = false
 			block.SetDelay()
+			b.touch(req.BlockNumber)
 
 			req.ResponseChan <- true
 
@@ -454,8 +744,15 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
+			if block.Superseded() {
+				b.touch(req.BlockNumber)
+				req.ResponseChan <- false
+				break
+			}
+
 			block.ConfirmedProgress = false
 			block.ConfirmedDone = true
+			b.touch(req.BlockNumber)
 
 			req.ResponseChan <- true
 
@@ -505,6 +802,7 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 			// Updated when last this block was attempted to be processed
 			b.Blocks[selected].SetLastAttempted()
 			b.Blocks[selected].UnconfirmedProgress = true
+			b.touch(selected)
 
 			// Asking client to proceed with processing of this block
 			nxt.ResponseChan <- struct {
@@ -553,6 +851,7 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 			b.Blocks[selected].SetLastAttempted()
 			b.Blocks[selected].ConfirmedProgress = true
+			b.touch(selected)
 
 			nxt.ResponseChan <- struct {
 				Status bool
@@ -595,6 +894,70 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 			stat.Total = b.Total
 			req.ResponseChan <- stat
 
+		case req := <-b.ListChan:
+
+			blocks := make([]*QueuedBlock, 0, len(b.Blocks))
+			for k, v := range b.Blocks {
+
+				blocks = append(blocks, &QueuedBlock{
+					Number:        k,
+					State:         v.State(),
+					Published:     v.Published,
+					LastAttempted: v.LastAttempted,
+					Delay:         v.Delay.String(),
+					NextAttempt:   v.NextAttempt(),
+				})
+
+			}
+
+			sort.Slice(blocks, func(i, j int) bool {
+				return blocks[i].Number < blocks[j].Number
+			})
+
+			req.ResponseChan <- blocks
+
+		case req := <-b.RetryChan:
+
+			block, ok := b.Blocks[req.BlockNumber]
+			if !ok || block.UnconfirmedProgress || block.ConfirmedProgress {
+				req.ResponseChan <- false
+				break
+			}
+
+			// Pretending it was last attempted long enough back,
+			// so that its delay has already elapsed
+			block.LastAttempted = time.Now().UTC().Add(-block.Delay)
+			b.touch(req.BlockNumber)
+
+			req.ResponseChan <- true
+
+		case req := <-b.ResetDelayChan:
+
+			block, ok := b.Blocks[req.BlockNumber]
+			if !ok {
+				req.ResponseChan <- false
+				break
+			}
+
+			block.ResetDelay()
+			b.touch(req.BlockNumber)
+
+			req.ResponseChan <- true
+
+		case req := <-b.DropChan:
+
+			// If some worker is processing this block right now, whatever
+			// it reports back about this block, to be ignored
+			if _, ok := b.Blocks[req.BlockNumber]; !ok {
+				req.ResponseChan <- false
+				break
+			}
+
+			delete(b.Blocks, req.BlockNumber)
+			b.forget(req.BlockNumber)
+
+			req.ResponseChan <- true
+
 		case udt := <-b.LatestChan:
 			// Latest block number seen by subscriber to
 			// sent to queue, to be used in when deciding whether some
@@ -610,11 +973,16 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 				if b.Blocks[k].ConfirmedDone {
 					delete(b.Blocks, k)
+					b.forget(k)
 					b.Total++ // Successfully processed #-of blocks
 				}
 
 			}
 
+			// Changes made to queue state since last time, being
+			// persisted, so that they survive restarts
+			b.flush(false)
+
 		}
 	}
 
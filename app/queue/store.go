@@ -0,0 +1,188 @@
+package queue
+
+import (
+	"log"
+)
+
+// Store - Durable storage of block processor queue state, so that blocks
+// which were being processed, backing off after failure or waiting for
+// confirmation, are not forgotten when `ette` restarts
+//
+// Implemented by database layer, so that queue doesn't need to know
+// where its state lives
+type Store interface {
+	// LoadQueue - All blocks which were present in queue, last time
+	// its state was saved
+	LoadQueue() (map[uint64]*Block, error)
+	// SaveQueue - Persists state of updated blocks & forgets about
+	// deleted ones, in one go
+	SaveQueue(updated map[uint64]*Block, deleted []uint64) error
+}
+
+// restore - Reloads queue state from durable storage, if any
+//
+// Blocks which were being processed when `ette` went down, are put
+// back into waiting phase, because no worker is going to report back
+// about them, so that retry queue manager picks them up
+func (b *BlockProcessorQueue) restore() {
+
+	if b.store == nil {
+		return
+	}
+
+	blocks, err := b.store.LoadQueue()
+	if err != nil {
+		log.Printf("[!] Failed to load block processor queue state : %s\n", err.Error())
+		return
+	}
+
+	for k, v := range blocks {
+
+		if v.UnconfirmedProgress || v.ConfirmedProgress {
+
+			v.UnconfirmedProgress = false
+			v.ConfirmedProgress = false
+			b.dirty[k] = struct{}{}
+
+		}
+
+		b.Blocks[k] = v
+
+	}
+
+	if len(blocks) != 0 {
+		log.Printf("[+] Restored %d block(s) into block processor queue\n", len(blocks))
+	}
+
+}
+
+// touch - Marks block state as changed, to be persisted on next flush
+func (b *BlockProcessorQueue) touch(num uint64) {
+
+	if b.store == nil {
+		return
+	}
+
+	delete(b.deleted, num)
+	b.dirty[num] = struct{}{}
+
+}
+
+// forget - Marks block as removed from queue, to be deleted
+// from durable storage on next flush
+func (b *BlockProcessorQueue) forget(num uint64) {
+
+	if b.store == nil {
+		return
+	}
+
+	delete(b.dirty, num)
+	b.deleted[num] = struct{}{}
+
+}
+
+// changes - Changes made to queue state, yet to be persisted, where
+// block states are copied, so that they can be written to durable storage,
+// while queue keeps going
+type changes struct {
+	updated map[uint64]*Block
+	deleted map[uint64]struct{}
+}
+
+// merge - Applies newer changes on top of these ones, so that what's
+// failed to be persisted, gets persisted along with newer ones
+func (c *changes) merge(_c *changes) {
+
+	for k, v := range _c.updated {
+
+		delete(c.deleted, k)
+		c.updated[k] = v
+
+	}
+
+	for k := range _c.deleted {
+
+		delete(c.updated, k)
+		c.deleted[k] = struct{}{}
+
+	}
+
+}
+
+// flush - Hands over all changes made to queue state since last successful
+// hand over, to saver go routine, without blocking, unless asked to wait,
+// when saver is busy
+//
+// If not handed over, they're attempted on next flush
+func (b *BlockProcessorQueue) flush(wait bool) {
+
+	if b.store == nil || (len(b.dirty) == 0 && len(b.deleted) == 0) {
+		return
+	}
+
+	_changes := &changes{
+		updated: make(map[uint64]*Block, len(b.dirty)),
+		deleted: b.deleted,
+	}
+
+	for k := range b.dirty {
+
+		if block, ok := b.Blocks[k]; ok {
+			_block := *block
+			_changes.updated[k] = &_block
+		}
+
+	}
+
+	if wait {
+		b.saveChan <- _changes
+	} else {
+
+		select {
+		case b.saveChan <- _changes:
+		default:
+			return
+		}
+
+	}
+
+	b.dirty = make(map[uint64]struct{})
+	b.deleted = make(map[uint64]struct{})
+
+}
+
+// save - Keeps persisting changes made to queue state, as they're handed
+// over, so that queue doesn't wait for database, while failed ones are
+// retried along with next changes
+//
+// Returns when no more changes are to be handed over, after attempting to
+// persist what's pending, letting waiting ones know it's done
+func (b *BlockProcessorQueue) save() {
+
+	defer close(b.done)
+
+	pending := &changes{
+		updated: make(map[uint64]*Block),
+		deleted: make(map[uint64]struct{}),
+	}
+
+	for _changes := range b.saveChan {
+
+		pending.merge(_changes)
+
+		deleted := make([]uint64, 0, len(pending.deleted))
+		for k := range pending.deleted {
+			deleted = append(deleted, k)
+		}
+
+		if err := b.store.SaveQueue(pending.updated, deleted); err != nil {
+			log.Printf("[!] Failed to save block processor queue state : %s\n", err.Error())
+			continue
+		}
+
+		pending.updated = make(map[uint64]*Block)
+		pending.deleted = make(map[uint64]struct{})
+
+	}
+
+}
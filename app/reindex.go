@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"time"
+
+	"github.com/gookit/color"
+	blk "github.com/itzmeanjan/ette/app/block"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// Reindex - Fetches all blocks in [from, to] range afresh, compares them with
+// what's persisted & replaces mismatches, while writing report of what was
+// changed into given file, if any, returns true if none of them failed
+//
+// When `to` is not provided, latest block, which has got required
+// confirmations, is used
+//
+// Config file must be read before invoking it
+func Reindex(from uint64, to uint64, toGiven bool, reportFile string) bool {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_connection := &d.BlockChainNodeConnection{
+		RPC:       getClient(true),
+		Websocket: getClient(false),
+	}
+	_db := db.Connect()
+
+	go _connection.HealthCheck(ctx)
+
+	client := _connection.RPC.Get()
+	if client == nil {
+		log.Print(color.Red.Sprintf("[!] No healthy blockchain node found"))
+		return false
+	}
+
+	head, err := client.BlockNumber(context.Background())
+	if err != nil {
+		log.Print(color.Red.Sprintf("[!] Failed to fetch latest block number : %s", err.Error()))
+		return false
+	}
+
+	// Blocks not having required confirmations yet, may still
+	// change, so they're not to be re-indexed
+	if head < cfg.GetBlockConfirmations() {
+		log.Print(color.Red.Sprintf("[!] No block has got %d confirmations yet", cfg.GetBlockConfirmations()))
+		return false
+	}
+
+	finalised := head - cfg.GetBlockConfirmations()
+	if !toGiven || to > finalised {
+		to = finalised
+	}
+
+	if from > to {
+		log.Print(color.Red.Sprintf("[!] Bad block range [ %d - %d ]", from, to))
+		return false
+	}
+
+	_start := time.Now().UTC()
+
+	summary := blk.Reindex(_connection, _db, from, to, nil)
+
+	log.Printf("[*] Re-indexed [ %d - %d ] in : %s [ Unchanged : %d, Inserted : %d, Updated : %d, Replaced : %d, Failed : %d ]\n",
+		from, to, time.Now().UTC().Sub(_start), summary.Unchanged, summary.Inserted, summary.Updated, summary.Replaced, summary.Failed)
+
+	if reportFile != "" {
+
+		data := summary.ToJSON()
+		if data == nil {
+			return false
+		}
+
+		if err := ioutil.WriteFile(reportFile, data, 0644); err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to write re-index report : %s", err.Error()))
+			return false
+		}
+
+		log.Printf("[*] Re-index report written to : %s\n", reportFile)
+
+	}
+
+	if summary.Failed != 0 {
+		log.Print(color.Red.Sprintf("[!] Failed to re-index %d block(s)", summary.Failed))
+		return false
+	}
+
+	log.Print(color.Green.Sprintf("[+] Re-indexed [ %d - %d ]", from, to))
+	return true
+
+}
@@ -3,6 +3,7 @@ package graph
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -18,10 +19,16 @@ import (
 
 var db *gorm.DB
 
+// reader - Handle to be used for read only queries, which are
+// routed to read replicas of database, if any
+var reader *gorm.DB
+
 // GetDatabaseConnection - Passing already connected database handle to this package,
-// so that it can be used for handling database queries for resolving graphQL queries
-func GetDatabaseConnection(conn *gorm.DB) {
+// so that it can be used for handling database queries for resolving graphQL queries,
+// along with handle to be used for read only queries
+func GetDatabaseConnection(conn *gorm.DB, _reader *gorm.DB) {
 	db = conn
+	reader = _reader
 }
 
 // Attempting to recover router context i.e. which holds client `APIKey` in request header,
@@ -114,10 +121,33 @@ func getGraphQLCompatibleBlock(ctx context.Context, block *data.Block, bookKeepi
 		TxRootHash:      block.TransactionRootHash,
 		ReceiptRootHash: block.ReceiptRootHash,
 		ExtraData:       extraData,
+		BaseFeePerGas:   block.BaseFee,
+		BlobGasUsed:     fmt.Sprintf("%d", block.BlobGasUsed),
+		ExcessBlobGas:   fmt.Sprintf("%d", block.ExcessBlobGas),
+		Withdrawals:     getGraphQLCompatibleWithdrawals(block.Withdrawals),
 	}, nil
 
 }
 
+// Converting JSON encoded withdrawals to graphQL compatible data structure
+func getGraphQLCompatibleWithdrawals(withdrawals []byte) []*model.Withdrawal {
+
+	_withdrawals := data.DecodeWithdrawals(withdrawals)
+	_model := make([]*model.Withdrawal, len(_withdrawals))
+
+	for k, v := range _withdrawals {
+		_model[k] = &model.Withdrawal{
+			Index:          fmt.Sprintf("%d", v.Index),
+			ValidatorIndex: fmt.Sprintf("%d", v.Validator),
+			Address:        v.Address,
+			Amount:         fmt.Sprintf("%d", v.Amount),
+		}
+	}
+
+	return _model
+
+}
+
 // Converting block array to graphQL compatible data structure
 func getGraphQLCompatibleBlocks(ctx context.Context, blocks *data.Blocks) ([]*model.Block, error) {
 	if blocks == nil {
@@ -161,8 +191,10 @@ func getGraphQLCompatibleTransaction(ctx context.Context, tx *data.Transaction,
 		data = fmt.Sprintf("0x%s", _h)
 	}
 
+	var _tx *model.Transaction
+
 	if !strings.HasPrefix(tx.Contract, "0x") {
-		return &model.Transaction{
+		_tx = &model.Transaction{
 			Hash:      tx.Hash,
 			From:      tx.From,
 			To:        tx.To,
@@ -175,23 +207,56 @@ func getGraphQLCompatibleTransaction(ctx context.Context, tx *data.Transaction,
 			Nonce:     fmt.Sprintf("%d", tx.Nonce),
 			State:     fmt.Sprintf("%d", tx.State),
 			BlockHash: tx.BlockHash,
-		}, nil
+		}
+	} else {
+		_tx = &model.Transaction{
+			Hash:      tx.Hash,
+			From:      tx.From,
+			To:        "",
+			Contract:  tx.Contract,
+			Value:     tx.Value,
+			Data:      data,
+			Gas:       fmt.Sprintf("%d", tx.Gas),
+			GasPrice:  tx.GasPrice,
+			Cost:      tx.Cost,
+			Nonce:     fmt.Sprintf("%d", tx.Nonce),
+			State:     fmt.Sprintf("%d", tx.State),
+			BlockHash: tx.BlockHash,
+		}
 	}
 
-	return &model.Transaction{
-		Hash:      tx.Hash,
-		From:      tx.From,
-		To:        "",
-		Contract:  tx.Contract,
-		Value:     tx.Value,
-		Data:      data,
-		Gas:       fmt.Sprintf("%d", tx.Gas),
-		GasPrice:  tx.GasPrice,
-		Cost:      tx.Cost,
-		Nonce:     fmt.Sprintf("%d", tx.Nonce),
-		State:     fmt.Sprintf("%d", tx.State),
-		BlockHash: tx.BlockHash,
-	}, nil
+	blobHashes := make([]string, len(tx.BlobHashes))
+	copy(blobHashes, tx.BlobHashes)
+
+	_tx.Type = int(tx.Type)
+	_tx.MaxFeePerGas = tx.MaxFeePerGas
+	_tx.MaxPriorityFeePerGas = tx.MaxPriorityFeePerGas
+	_tx.EffectiveGasPrice = tx.EffectiveGasPrice
+	_tx.GasUsed = fmt.Sprintf("%d", tx.GasUsed)
+	_tx.AccessList = getGraphQLCompatibleAccessList(tx.AccessList)
+	_tx.BlobGas = fmt.Sprintf("%d", tx.BlobGas)
+	_tx.MaxFeePerBlobGas = tx.MaxFeePerBlobGas
+	_tx.BlobGasPrice = tx.BlobGasPrice
+	_tx.BlobHashes = blobHashes
+
+	return _tx, nil
+}
+
+// Converting JSON encoded access list to graphQL compatible data structure
+func getGraphQLCompatibleAccessList(accessList []byte) []*model.AccessTuple {
+
+	_accessList := data.DecodeAccessList(accessList)
+	_model := make([]*model.AccessTuple, len(_accessList))
+
+	for k, v := range _accessList {
+		_model[k] = &model.AccessTuple{
+			Address:     v.Address,
+			StorageKeys: v.StorageKeys,
+		}
+	}
+
+	return _model
+
 }
 
 // Converting transaction array to graphQL compatible data structure
@@ -306,3 +371,141 @@ func FillUpTopicArray(topics []string) []string {
 	return result
 
 }
+
+// Converting token transfer data to graphQL compatible data structure
+func getGraphQLCompatibleTokenTransfer(transfer *data.TokenTransfer) *model.TokenTransfer {
+	return &model.TokenTransfer{
+		Token:      transfer.Token,
+		Standard:   transfer.Standard,
+		From:       transfer.From,
+		To:         transfer.To,
+		TokenID:    transfer.TokenID,
+		Amount:     transfer.Amount,
+		LogIndex:   fmt.Sprintf("%d", transfer.Index),
+		BatchIndex: fmt.Sprintf("%d", transfer.BatchIndex),
+		TxHash:     transfer.TransactionHash,
+		BlockHash:  transfer.BlockHash,
+	}
+}
+
+// Converting token transfer array to graphQL compatible data structure
+func getGraphQLCompatibleTokenTransfers(ctx context.Context, transfers *data.TokenTransfers) ([]*model.TokenTransfer, error) {
+	if transfers == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(transfers.TokenTransfers) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	if err := doBookKeeping(ctx, transfers.ToJSON()); err != nil {
+		return nil, errors.New("Book keeping failed")
+	}
+
+	_transfers := make([]*model.TokenTransfer, len(transfers.TokenTransfers))
+
+	for k, v := range transfers.TokenTransfers {
+		_transfers[k] = getGraphQLCompatibleTokenTransfer(v)
+	}
+
+	return _transfers, nil
+}
+
+// Converting internal tx into graphQL compatible data structure
+func getGraphQLCompatibleInternalTransaction(tx *data.InternalTransaction) *model.InternalTransaction {
+	return &model.InternalTransaction{
+		TxHash:    tx.TransactionHash,
+		Index:     fmt.Sprintf("%d", tx.Index),
+		Depth:     fmt.Sprintf("%d", tx.Depth),
+		Type:      tx.Type,
+		From:      tx.From,
+		To:        tx.To,
+		Contract:  tx.Contract,
+		Value:     tx.Value,
+		Gas:       fmt.Sprintf("%d", tx.Gas),
+		GasUsed:   fmt.Sprintf("%d", tx.GasUsed),
+		Error:     tx.Error,
+		BlockHash: tx.BlockHash,
+	}
+}
+
+// Converting internal tx array to graphQL compatible data structure
+func getGraphQLCompatibleInternalTransactions(ctx context.Context, txs *data.InternalTransactions) ([]*model.InternalTransaction, error) {
+	if txs == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(txs.InternalTransactions) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	if err := doBookKeeping(ctx, txs.ToJSON()); err != nil {
+		return nil, errors.New("Book keeping failed")
+	}
+
+	_txs := make([]*model.InternalTransaction, len(txs.InternalTransactions))
+
+	for k, v := range txs.InternalTransactions {
+		_txs[k] = getGraphQLCompatibleInternalTransaction(v)
+	}
+
+	return _txs, nil
+}
+
+// Converting decoded event/ call arguments into graphQL compatible
+// data structure, where value is JSON encoded, because it can be
+// of any ABI type
+func getGraphQLCompatibleDecodedArgs(args []*data.DecodedArg) []*model.DecodedArg {
+
+	_args := make([]*model.DecodedArg, 0, len(args))
+
+	for _, v := range args {
+
+		value, err := json.Marshal(v.Value)
+		if err != nil {
+			log.Printf("[!] Failed to encode decoded argument to JSON : %s\n", err.Error())
+			continue
+		}
+
+		_args = append(_args, &model.DecodedArg{
+			Name:    v.Name,
+			Type:    v.Type,
+			Indexed: v.Indexed,
+			Value:   string(value),
+		})
+
+	}
+
+	return _args
+
+}
+
+// Converting decoded event log into graphQL compatible data structure
+func getGraphQLCompatibleDecodedEvent(event *data.DecodedEvent) *model.DecodedEvent {
+
+	if event == nil {
+		return nil
+	}
+
+	return &model.DecodedEvent{
+		Name:      event.Name,
+		Signature: event.Signature,
+		Args:      getGraphQLCompatibleDecodedArgs(event.Args),
+	}
+
+}
+
+// Converting decoded tx input data into graphQL compatible data structure
+func getGraphQLCompatibleDecodedCall(call *data.DecodedCall) *model.DecodedCall {
+
+	if call == nil {
+		return nil
+	}
+
+	return &model.DecodedCall{
+		Method:    call.Method,
+		Signature: call.Signature,
+		Inputs:    getGraphQLCompatibleDecodedArgs(call.Inputs),
+	}
+
+}
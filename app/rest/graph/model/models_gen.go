@@ -2,44 +2,119 @@
 
 package model
 
+type AccessTuple struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
 type Block struct {
-	Hash            string  `json:"hash"`
-	Number          string  `json:"number"`
-	Time            string  `json:"time"`
-	ParentHash      string  `json:"parentHash"`
-	Difficulty      string  `json:"difficulty"`
-	GasUsed         string  `json:"gasUsed"`
-	GasLimit        string  `json:"gasLimit"`
-	Nonce           string  `json:"nonce"`
-	Miner           string  `json:"miner"`
-	Size            float64 `json:"size"`
-	StateRootHash   string  `json:"stateRootHash"`
-	UncleHash       string  `json:"uncleHash"`
-	TxRootHash      string  `json:"txRootHash"`
-	ReceiptRootHash string  `json:"receiptRootHash"`
-	ExtraData       string  `json:"extraData"`
+	Hash            string        `json:"hash"`
+	Number          string        `json:"number"`
+	Time            string        `json:"time"`
+	ParentHash      string        `json:"parentHash"`
+	Difficulty      string        `json:"difficulty"`
+	GasUsed         string        `json:"gasUsed"`
+	GasLimit        string        `json:"gasLimit"`
+	Nonce           string        `json:"nonce"`
+	Miner           string        `json:"miner"`
+	Size            float64       `json:"size"`
+	StateRootHash   string        `json:"stateRootHash"`
+	UncleHash       string        `json:"uncleHash"`
+	TxRootHash      string        `json:"txRootHash"`
+	ReceiptRootHash string        `json:"receiptRootHash"`
+	ExtraData       string        `json:"extraData"`
+	BaseFeePerGas   string        `json:"baseFeePerGas"`
+	BlobGasUsed     string        `json:"blobGasUsed"`
+	ExcessBlobGas   string        `json:"excessBlobGas"`
+	Withdrawals     []*Withdrawal `json:"withdrawals"`
+}
+
+type DecodedArg struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+	Value   string `json:"value"`
+}
+
+type DecodedCall struct {
+	Method    string        `json:"method"`
+	Signature string        `json:"signature"`
+	Inputs    []*DecodedArg `json:"inputs"`
+}
+
+type DecodedEvent struct {
+	Name      string        `json:"name"`
+	Signature string        `json:"signature"`
+	Args      []*DecodedArg `json:"args"`
 }
 
 type Event struct {
-	Origin    string   `json:"origin"`
-	Index     string   `json:"index"`
-	Topics    []string `json:"topics"`
-	Data      string   `json:"data"`
-	TxHash    string   `json:"txHash"`
-	BlockHash string   `json:"blockHash"`
+	Origin    string        `json:"origin"`
+	Index     string        `json:"index"`
+	Topics    []string      `json:"topics"`
+	Data      string        `json:"data"`
+	TxHash    string        `json:"txHash"`
+	BlockHash string        `json:"blockHash"`
+	Decoded   *DecodedEvent `json:"decoded"`
 }
 
-type Transaction struct {
-	Hash      string `json:"hash"`
+type InternalTransaction struct {
+	TxHash    string `json:"txHash"`
+	Index     string `json:"index"`
+	Depth     string `json:"depth"`
+	Type      string `json:"type"`
 	From      string `json:"from"`
 	To        string `json:"to"`
 	Contract  string `json:"contract"`
 	Value     string `json:"value"`
-	Data      string `json:"data"`
 	Gas       string `json:"gas"`
-	GasPrice  string `json:"gasPrice"`
-	Cost      string `json:"cost"`
-	Nonce     string `json:"nonce"`
-	State     string `json:"state"`
+	GasUsed   string `json:"gasUsed"`
+	Error     string `json:"error"`
 	BlockHash string `json:"blockHash"`
 }
+
+type TokenTransfer struct {
+	Token      string `json:"token"`
+	Standard   string `json:"standard"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	TokenID    string `json:"tokenId"`
+	Amount     string `json:"amount"`
+	LogIndex   string `json:"logIndex"`
+	BatchIndex string `json:"batchIndex"`
+	TxHash     string `json:"txHash"`
+	BlockHash  string `json:"blockHash"`
+}
+
+type Transaction struct {
+	Hash                 string         `json:"hash"`
+	From                 string         `json:"from"`
+	To                   string         `json:"to"`
+	Contract             string         `json:"contract"`
+	Value                string         `json:"value"`
+	Data                 string         `json:"data"`
+	Gas                  string         `json:"gas"`
+	GasPrice             string         `json:"gasPrice"`
+	Cost                 string         `json:"cost"`
+	Nonce                string         `json:"nonce"`
+	State                string         `json:"state"`
+	BlockHash            string         `json:"blockHash"`
+	Type                 int            `json:"type"`
+	MaxFeePerGas         string         `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string         `json:"maxPriorityFeePerGas"`
+	EffectiveGasPrice    string         `json:"effectiveGasPrice"`
+	GasUsed              string         `json:"gasUsed"`
+	AccessList           []*AccessTuple `json:"accessList"`
+	BlobGas              string         `json:"blobGas"`
+	MaxFeePerBlobGas     string         `json:"maxFeePerBlobGas"`
+	BlobGasPrice         string         `json:"blobGasPrice"`
+	BlobHashes           []string       `json:"blobHashes"`
+	Decoded              *DecodedCall   `json:"decoded"`
+}
+
+type Withdrawal struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validatorIndex"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
@@ -11,19 +11,30 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	cmn "github.com/itzmeanjan/ette/app/common"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	_db "github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/decoder"
 	"github.com/itzmeanjan/ette/app/rest/graph/generated"
 	"github.com/itzmeanjan/ette/app/rest/graph/model"
 )
 
+func (r *eventResolver) Decoded(ctx context.Context, obj *model.Event) (*model.DecodedEvent, error) {
+	data, err := hexutil.Decode(obj.Data)
+	if err != nil && obj.Data != "" {
+		return nil, nil
+	}
+
+	return getGraphQLCompatibleDecodedEvent(decoder.Event(db, obj.Origin, obj.Topics, data)), nil
+}
+
 func (r *queryResolver) BlockByHash(ctx context.Context, hash string) (*model.Block, error) {
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByHash(reader, common.HexToHash(hash)), true)
 }
 
 func (r *queryResolver) BlockByNumber(ctx context.Context, number string) (*model.Block, error) {
@@ -32,7 +43,7 @@ func (r *queryResolver) BlockByNumber(ctx context.Context, number string) (*mode
 		return nil, errors.New("Bad Block Number")
 	}
 
-	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByNumber(db, _number), true)
+	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByNumber(reader, _number), true)
 }
 
 func (r *queryResolver) BlocksByNumberRange(ctx context.Context, from string, to string) ([]*model.Block, error) {
@@ -41,7 +52,7 @@ func (r *queryResolver) BlocksByNumberRange(ctx context.Context, from string, to
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByNumberRange(db, _from, _to))
+	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByNumberRange(reader, _from, _to))
 }
 
 func (r *queryResolver) BlocksByTimeRange(ctx context.Context, from string, to string) ([]*model.Block, error) {
@@ -50,7 +61,7 @@ func (r *queryResolver) BlocksByTimeRange(ctx context.Context, from string, to s
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByTimeRange(db, _from, _to))
+	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByTimeRange(reader, _from, _to))
 }
 
 func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Transaction, error) {
@@ -58,7 +69,7 @@ func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Tr
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(reader, common.HexToHash(hash)), true)
 }
 
 func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash string) (int, error) {
@@ -66,7 +77,7 @@ func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash st
 		return 0, errors.New("Bad Block Hash")
 	}
 
-	count := int(_db.GetTransactionCountByBlockHash(db, common.HexToHash(hash)))
+	count := int(_db.GetTransactionCountByBlockHash(reader, common.HexToHash(hash)))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -86,7 +97,7 @@ func (r *queryResolver) TransactionsByBlockHash(ctx context.Context, hash string
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockHash(reader, common.HexToHash(hash)))
 }
 
 func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, number string) (int, error) {
@@ -95,7 +106,7 @@ func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, numbe
 		return 0, errors.New("Bad Block Number")
 	}
 
-	count := int(_db.GetTransactionCountByBlockNumber(db, _number))
+	count := int(_db.GetTransactionCountByBlockNumber(reader, _number))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -116,7 +127,7 @@ func (r *queryResolver) TransactionsByBlockNumber(ctx context.Context, number st
 		return nil, errors.New("Bad Block Number")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockNumber(db, _number))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockNumber(reader, _number))
 }
 
 func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -129,7 +140,7 @@ func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Con
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountFromAccountByBlockNumberRange(reader, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -154,7 +165,7 @@ func (r *queryResolver) TransactionsFromAccountByNumberRange(ctx context.Context
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockNumberRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -167,7 +178,7 @@ func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Conte
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountFromAccountByBlockTimeRange(reader, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -192,7 +203,7 @@ func (r *queryResolver) TransactionsFromAccountByTimeRange(ctx context.Context,
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockTimeRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -205,7 +216,7 @@ func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Conte
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountToAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountToAccountByBlockNumberRange(reader, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -230,7 +241,7 @@ func (r *queryResolver) TransactionsToAccountByNumberRange(ctx context.Context,
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockNumberRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -243,7 +254,7 @@ func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountToAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountToAccountByBlockTimeRange(reader, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -268,7 +279,7 @@ func (r *queryResolver) TransactionsToAccountByTimeRange(ctx context.Context, ac
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockTimeRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error) {
@@ -285,7 +296,7 @@ func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountBetweenAccountsByBlockNumberRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	count := int(_db.GetTransactionCountBetweenAccountsByBlockNumberRange(reader, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -314,7 +325,7 @@ func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Con
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockNumberRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockNumberRange(reader, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 }
 
 func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error) {
@@ -331,7 +342,7 @@ func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.C
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountBetweenAccountsByBlockTimeRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	count := int(_db.GetTransactionCountBetweenAccountsByBlockTimeRange(reader, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -360,7 +371,7 @@ func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Conte
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockTimeRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockTimeRange(reader, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 }
 
 func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
@@ -373,7 +384,7 @@ func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Con
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockNumberRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
@@ -386,7 +397,7 @@ func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Conte
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockTimeRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, account string, nonce string) (*model.Transaction, error) {
@@ -399,7 +410,49 @@ func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, acc
 		return nil, errors.New("Bad Account Nonce")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionFromAccountWithNonce(db, common.HexToAddress(account), _nonce), true)
+	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionFromAccountWithNonce(reader, common.HexToAddress(account), _nonce), true)
+}
+
+func (r *queryResolver) InternalTransactions(ctx context.Context, hash string) ([]*model.InternalTransaction, error) {
+	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
+		return nil, errors.New("Bad Transaction Hash")
+	}
+
+	return getGraphQLCompatibleInternalTransactions(ctx, _db.GetInternalTransactionsByTransactionHash(reader, common.HexToHash(hash)))
+}
+
+func (r *queryResolver) InternalTransactionsByBlockHash(ctx context.Context, hash string) ([]*model.InternalTransaction, error) {
+	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
+		return nil, errors.New("Bad Block Hash")
+	}
+
+	return getGraphQLCompatibleInternalTransactions(ctx, _db.GetInternalTransactionsByBlockHash(reader, common.HexToHash(hash)))
+}
+
+func (r *queryResolver) InternalTransactionsOfAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.InternalTransaction, error) {
+	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleInternalTransactions(ctx, _db.GetInternalTransactionsOfAccountByBlockNumberRange(reader, common.HexToAddress(account), _from, _to))
+}
+
+func (r *queryResolver) InternalTransactionsOfAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.InternalTransaction, error) {
+	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleInternalTransactions(ctx, _db.GetInternalTransactionsOfAccountByBlockTimeRange(reader, common.HexToAddress(account), _from, _to))
 }
 
 func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error) {
@@ -412,7 +465,7 @@ func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, con
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockNumberRange(db, common.HexToAddress(contract), _from, _to))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockNumberRange(reader, common.HexToAddress(contract), _from, _to))
 }
 
 func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error) {
@@ -425,7 +478,7 @@ func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contr
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockTimeRange(db, common.HexToAddress(contract), _from, _to))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockTimeRange(reader, common.HexToAddress(contract), _from, _to))
 }
 
 func (r *queryResolver) EventsByBlockHash(ctx context.Context, hash string) ([]*model.Event, error) {
@@ -433,7 +486,7 @@ func (r *queryResolver) EventsByBlockHash(ctx context.Context, hash string) ([]*
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByBlockHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByBlockHash(reader, common.HexToHash(hash)))
 }
 
 func (r *queryResolver) EventsByTxHash(ctx context.Context, hash string) ([]*model.Event, error) {
@@ -441,7 +494,7 @@ func (r *queryResolver) EventsByTxHash(ctx context.Context, hash string) ([]*mod
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByTransactionHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByTransactionHash(reader, common.HexToHash(hash)))
 }
 
 func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error) {
@@ -454,7 +507,7 @@ func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Co
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockNumberRange(db, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockNumberRange(reader, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
 }
 
 func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error) {
@@ -467,7 +520,7 @@ func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Cont
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockTimeRange(db, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockTimeRange(reader, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
 }
 
 func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract string, x int) ([]*model.Event, error) {
@@ -479,7 +532,7 @@ func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract st
 		return nil, errors.New("Too Many Events Requested")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetLastXEventsFromContract(db, common.HexToAddress(contract), x))
+	return getGraphQLCompatibleEvents(ctx, _db.GetLastXEventsFromContract(reader, common.HexToAddress(contract), x))
 }
 
 func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash string, index string) (*model.Event, error) {
@@ -492,7 +545,7 @@ func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash st
 		return nil, errors.New("Bad Log Index")
 	}
 
-	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockHashAndLogIndex(db, common.HexToHash(hash), uint(_index)), true)
+	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockHashAndLogIndex(reader, common.HexToHash(hash), uint(_index)), true)
 }
 
 func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, number string, index string) (*model.Event, error) {
@@ -506,13 +559,116 @@ func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, numbe
 		return nil, errors.New("Bad Log Index")
 	}
 
-	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockNumberAndLogIndex(db, _number, uint(_index)), true)
+	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockNumberAndLogIndex(reader, _number, uint(_index)), true)
+}
+
+func (r *queryResolver) TokenTransfersByNumberRange(ctx context.Context, token string, from string, to string) ([]*model.TokenTransfer, error) {
+	if !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+		return nil, errors.New("Bad Token Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfTokenByBlockNumberRange(reader, common.HexToAddress(token), _from, _to))
 }
 
+func (r *queryResolver) TokenTransfersByTimeRange(ctx context.Context, token string, from string, to string) ([]*model.TokenTransfer, error) {
+	if !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+		return nil, errors.New("Bad Token Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfTokenByBlockTimeRange(reader, common.HexToAddress(token), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfHolderByNumberRange(ctx context.Context, holder string, from string, to string) ([]*model.TokenTransfer, error) {
+	if !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+		return nil, errors.New("Bad Holder Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfHolderByBlockNumberRange(reader, common.HexToAddress(holder), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfHolderByTimeRange(ctx context.Context, holder string, from string, to string) ([]*model.TokenTransfer, error) {
+	if !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+		return nil, errors.New("Bad Holder Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfHolderByBlockTimeRange(reader, common.HexToAddress(holder), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfHolderForTokenByNumberRange(ctx context.Context, token string, holder string, from string, to string) ([]*model.TokenTransfer, error) {
+	if !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+		return nil, errors.New("Bad Token Address")
+	}
+
+	if !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+		return nil, errors.New("Bad Holder Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfHolderForTokenByBlockNumberRange(reader, common.HexToAddress(token), common.HexToAddress(holder), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfHolderForTokenByTimeRange(ctx context.Context, token string, holder string, from string, to string) ([]*model.TokenTransfer, error) {
+	if !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+		return nil, errors.New("Bad Token Address")
+	}
+
+	if !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+		return nil, errors.New("Bad Holder Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfHolderForTokenByBlockTimeRange(reader, common.HexToAddress(token), common.HexToAddress(holder), _from, _to))
+}
+
+func (r *transactionResolver) Decoded(ctx context.Context, obj *model.Transaction) (*model.DecodedCall, error) {
+	data, err := hexutil.Decode(obj.Data)
+	if err != nil {
+		return nil, nil
+	}
+
+	return getGraphQLCompatibleDecodedCall(decoder.Call(db, obj.To, data)), nil
+}
+
+// Event returns generated.EventResolver implementation.
+func (r *Resolver) Event() generated.EventResolver { return &eventResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Transaction returns generated.TransactionResolver implementation.
+func (r *Resolver) Transaction() generated.TransactionResolver { return &transactionResolver{r} }
+
+type eventResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type transactionResolver struct{ *Resolver }
 
 // !!! WARNING !!!
 // The code below was going to be deleted when updating resolvers. It has been copied here so you have
@@ -525,5 +681,5 @@ func (r *queryResolver) TransactionByHash(ctx context.Context, hash string) (*mo
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(reader, common.HexToHash(hash)), true)
 }
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -14,16 +15,22 @@ import (
 	"github.com/foolin/goview"
 	"github.com/foolin/goview/supports/ginview"
 	"github.com/gin-contrib/cors"
+	"github.com/gin-contrib/sse"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
+	blk "github.com/itzmeanjan/ette/app/block"
+	"github.com/itzmeanjan/ette/app/broker"
 	cmn "github.com/itzmeanjan/ette/app/common"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/decoder"
+	"github.com/itzmeanjan/ette/app/metrics"
 	ps "github.com/itzmeanjan/ette/app/pubsub"
+	q "github.com/itzmeanjan/ette/app/queue"
 	"github.com/itzmeanjan/ette/app/rest/graph/generated"
 	"gorm.io/gorm"
 
@@ -32,8 +39,16 @@ import (
 	"github.com/itzmeanjan/ette/app/rest/graph"
 )
 
+// sseHeartbeatInterval - Server-Sent Events connection, on which nothing has
+// been written for this long, gets heartbeat
+const sseHeartbeatInterval = time.Duration(15) * time.Second
+
 // RunHTTPServer - Holds definition for all REST API(s) to be exposed
-func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Client) {
+func RunHTTPServer(_connection *d.BlockChainNodeConnection, _db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Client, _broker broker.Broker, _queue *q.BlockProcessorQueue) {
+
+	// Read only queries are routed to read replicas of database, if any,
+	// while writes & reads following them are performed on primary
+	_reader := db.Reader(_db)
 
 	respondWithJSON := func(data []byte, c *gin.Context) {
 
@@ -60,6 +75,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				db.PutDataDeliveryInfo(_db, user.Address, "/v1/transaction", uint64(len(data)))
 			case strings.HasPrefix(uri, "/v1/event"):
 				db.PutDataDeliveryInfo(_db, user.Address, "/v1/event", uint64(len(data)))
+			case strings.HasPrefix(uri, "/v1/transfer"):
+				db.PutDataDeliveryInfo(_db, user.Address, "/v1/transfer", uint64(len(data)))
 			}
 
 			return
@@ -71,6 +88,45 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		return
 	}
 
+	// Client can ask for decoded form of event log(s)/ tx input data, by
+	// passing `decode=true` as query param, which is attached only when
+	// ABI of respective contract is known to `ette`
+	isDecodingRequested := func(c *gin.Context) bool {
+		return strings.ToLower(c.Query("decode")) == "true"
+	}
+
+	decodeEvent := func(c *gin.Context, event *d.Event) *d.Event {
+		if !isDecodingRequested(c) {
+			return event
+		}
+
+		return decoder.DecodeEvent(_db, event)
+	}
+
+	decodeEvents := func(c *gin.Context, events *d.Events) *d.Events {
+		if !isDecodingRequested(c) {
+			return events
+		}
+
+		return decoder.DecodeEvents(_db, events)
+	}
+
+	decodeTransaction := func(c *gin.Context, tx *d.Transaction) *d.Transaction {
+		if !isDecodingRequested(c) {
+			return tx
+		}
+
+		return decoder.DecodeTransaction(_db, tx)
+	}
+
+	decodeTransactions := func(c *gin.Context, txs *d.Transactions) *d.Transactions {
+		if !isDecodingRequested(c) {
+			return txs
+		}
+
+		return decoder.DecodeTransactions(_db, txs)
+	}
+
 	// Validates sessionId, which is passed as cookie for
 	// `/v1/dashboard/*` endpoints
 	//
@@ -89,6 +145,33 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		return address
 	}
 
+	// Validates adminSessionId, which is passed as cookie for
+	// `/v1/admin/*` endpoints, only session of `Admin` address
+	// set in `.env` file is accepted
+	//
+	// This cookie is set when admin login is performed
+	validateAdminSessionID := func(c *gin.Context) {
+
+		sessionID, err := c.Cookie("AdminSessionID")
+		if err == http.ErrNoCookie {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"msg": "Admin Login Required",
+			})
+			return
+		}
+
+		address, err := _redisClient.Get(context.Background(), sessionID).Result()
+		if err != nil || common.HexToAddress(address) != common.HexToAddress(cfg.Get("Admin")) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"msg": "Admin Login Required",
+			})
+			return
+		}
+
+		c.Next()
+
+	}
+
 	// For any historical query request
 	// APIKey needs to be delivered in header
 	//
@@ -137,7 +220,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 	// Checking whether this `ette` instance support
 	// historical data query or not
 	checkEtteHistoricalMode := func(c *gin.Context) {
-		if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3") {
+		if !cfg.IsHistorical() {
 			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
 				"msg": "Disabled Feature",
 			})
@@ -151,7 +234,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 	// real-time data delivery or not, if not letting client know
 	// about it & closing connection
 	checkEtteRealTimeMode := func(conn *websocket.Conn) bool {
-		if !(cfg.Get("EtteMode") == "2" || cfg.Get("EtteMode") == "3") {
+		if !cfg.IsRealtime() {
 			if err := conn.WriteJSON(&ps.SubscriptionResponse{Code: 0, Message: "Disabled Feature"}); err != nil {
 				log.Printf("[!] Failed to write message : %s\n", err.Error())
 			}
@@ -161,6 +244,69 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		return true
 	}
 
+	// Registers webhook for app identified by API key, after validating
+	// topic, URL & secret, which is done same way, whether it's being
+	// done from dashboard or using API
+	registerWebhook := func(c *gin.Context, apiKey string, payload *d.WebhookPayload) {
+
+		if !(&ps.SubscriptionRequest{Name: payload.Topic}).IsValidTopic() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad topic",
+			})
+			return
+		}
+
+		if !payload.IsValidURL() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad URL",
+			})
+			return
+		}
+
+		if !payload.IsValidSecret() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad secret",
+			})
+			return
+		}
+
+		webhook := db.RegisterWebhook(_db, apiKey, payload.Topic, payload.URL, payload.Secret)
+		if webhook == nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"msg": "Failed to register webhook",
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, webhook)
+
+	}
+
+	// Responds with latest dead letters of webhook, if it's registered for any
+	// of apps created by user
+	respondWithDeadLetters := func(c *gin.Context, id string, address common.Address) {
+
+		if !db.IsWebhookOwnedBy(_db, id, address) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+			return
+		}
+
+		letters := db.GetWebhookDeadLetters(_reader, id, 100)
+		if letters == nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"msg": "Failed to fetch dead letters",
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"deadLetters": letters,
+		})
+
+	}
+
 	// Checking if user has asked to run webserver in production mode or not
 	checkIfInProduction := func() bool {
 		return strings.ToLower(cfg.Get("Production")) == "yes"
@@ -179,6 +325,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 	// enabled cors
 	router.Use(cors.Default())
+	// keeping track of time taken to serve each request
+	router.Use(metrics.Middleware())
 
 	router.HTMLRender = ginview.New(goview.Config{
 		Root:         "./views",
@@ -410,15 +558,120 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		grp.GET("/dashboard/webhooks", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			if webhooks := db.GetWebhooksByUserAddress(_db, common.HexToAddress(address)); webhooks != nil {
+				c.JSON(http.StatusOK, gin.H{
+					"webhooks": webhooks,
+				})
+				return
+			}
+
+			c.JSON(http.StatusNoContent, gin.H{
+				"msg": "No webhooks registered yet",
+			})
+
+		})
+
+		grp.POST("/dashboard/newWebhook", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			var payload d.WebhookPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Webhook Payload",
+				})
+				return
+			}
+
+			// Webhook can be registered only for app created by
+			// user, who is logged in
+			user := db.GetUserFromAPIKey(_db, payload.APIKey)
+			if user == nil || common.HexToAddress(user.Address) != common.HexToAddress(address) {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Bad API Key",
+				})
+				return
+			}
+
+			registerWebhook(c, user.APIKey, &payload)
+
+		})
+
+		grp.POST("/dashboard/deleteWebhook", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			var payload struct {
+				ID string `json:"id" binding:"required"`
+			}
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Webhook Payload",
+				})
+				return
+			}
+
+			if !db.DeleteWebhook(_db, payload.ID, common.HexToAddress(address)) {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to delete webhook",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		grp.GET("/dashboard/webhook/dead", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			respondWithDeadLetters(c, c.Query("id"), common.HexToAddress(address))
+
+		})
+
 		// For checking `ette`'s syncing status
 		grp.GET("/synced", func(c *gin.Context) {
 
 			currentBlockNumber := _status.GetLatestBlockNumber()
-			blockCountInDB := _status.BlockCountInDB()
-			remaining := (currentBlockNumber + 1) - blockCountInDB
 			elapsed := _status.ElapsedTime()
 
-			if cfg.Get("EtteMode") == "2" {
+			// Only blocks from first one to be indexed, as per `StartBlock`,
+			// `HistoryWindow` & retention policies, are to be synced, so
+			// only those persisted in that range are counted
+			var toBeSynced, blockCountInDB uint64
+			if first := blk.FirstBlock(_status); currentBlockNumber >= first {
+				toBeSynced = currentBlockNumber + 1 - first
+				blockCountInDB = db.GetBlockCountInRange(_reader, first, currentBlockNumber)
+			}
+
+			remaining := toBeSynced - blockCountInDB
+
+			if !cfg.IsHistorical() {
 				c.JSON(http.StatusOK, gin.H{
 					"processed": _status.Done(),
 					"elapsed":   elapsed.String(),
@@ -426,18 +679,30 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			status := fmt.Sprintf("%.2f %%", (float64(blockCountInDB)/float64(currentBlockNumber+1))*100)
+			status := "100.00 %"
+			if toBeSynced > 0 {
+				status = fmt.Sprintf("%.2f %%", (float64(blockCountInDB)/float64(toBeSynced))*100)
+			}
+
 			eta := "0s"
-			if remaining > 0 {
+			if remaining > 0 && _status.Done() > 0 {
 				eta = (time.Duration((elapsed.Seconds()/float64(_status.Done()))*float64(remaining)) * time.Second).String()
 			}
 
-			c.JSON(http.StatusOK, gin.H{
+			resp := gin.H{
 				"synced":    status,
 				"processed": _status.Done(),
 				"elapsed":   elapsed.String(),
 				"eta":       eta,
-			})
+			}
+
+			// How far each read replica is behind primary, as of
+			// last health check, when queries are routed to them
+			if replicas := db.Replicas(_db); replicas != nil {
+				resp["replicas"] = replicas.Stat()
+			}
+
+			c.JSON(http.StatusOK, resp)
 
 		})
 
@@ -450,7 +715,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			// Block hash based all tx retrieval request handler
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 && tx == "yes" {
-				if tx := db.GetTransactionsByBlockHash(_db, common.HexToHash(hash)); tx != nil {
+				if tx := db.GetTransactionsByBlockHash(_reader, common.HexToHash(hash)); tx != nil {
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -472,7 +737,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsByBlockNumber(_db, _num); tx != nil {
+				if tx := db.GetTransactionsByBlockNumber(_reader, _num); tx != nil {
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -485,7 +750,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			// Block hash based single block retrieval request handler
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
-				if block := db.GetBlockByHash(_db, common.HexToHash(hash)); block != nil {
+				if block := db.GetBlockByHash(_reader, common.HexToHash(hash)); block != nil {
 					respondWithJSON(block.ToJSON(), c)
 					return
 				}
@@ -507,7 +772,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if block := db.GetBlockByNumber(_db, _num); block != nil {
+				if block := db.GetBlockByNumber(_reader, _num); block != nil {
 					respondWithJSON(block.ToJSON(), c)
 					return
 				}
@@ -533,7 +798,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if blocks := db.GetBlocksByNumberRange(_db, _from, _to); blocks != nil {
+				if blocks := db.GetBlocksByNumberRange(_reader, _from, _to); blocks != nil {
 					respondWithJSON(blocks.ToJSON(), c)
 					return
 				}
@@ -559,7 +824,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if blocks := db.GetBlocksByTimeRange(_db, _from, _to); blocks != nil {
+				if blocks := db.GetBlocksByTimeRange(_reader, _from, _to); blocks != nil {
 					respondWithJSON(blocks.ToJSON(), c)
 					return
 				}
@@ -583,8 +848,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			// Simply returns single tx object, when queried using tx hash
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
-				if tx := db.GetTransactionByHash(_db, common.HexToHash(hash)); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionByHash(_reader, common.HexToHash(hash)); tx != nil {
+					respondWithJSON(decodeTransaction(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -627,8 +892,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionFromAccountWithNonce(_db, common.HexToAddress(fromAccount), _nonce); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionFromAccountWithNonce(_reader, common.HexToAddress(fromAccount), _nonce); tx != nil {
+					respondWithJSON(decodeTransaction(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -651,8 +916,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetContractCreationTransactionsFromAccountByBlockNumberRange(_db, common.HexToAddress(deployer), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetContractCreationTransactionsFromAccountByBlockNumberRange(_reader, common.HexToAddress(deployer), _fromBlock, _toBlock); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -675,8 +940,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetContractCreationTransactionsFromAccountByBlockTimeRange(_db, common.HexToAddress(deployer), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetContractCreationTransactionsFromAccountByBlockTimeRange(_reader, common.HexToAddress(deployer), _fromTime, _toTime); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -699,8 +964,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsBetweenAccountsByBlockNumberRange(_db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionsBetweenAccountsByBlockNumberRange(_reader, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -723,8 +988,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsBetweenAccountsByBlockTimeRange(_db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionsBetweenAccountsByBlockTimeRange(_reader, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -747,8 +1012,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsFromAccountByBlockNumberRange(_db, common.HexToAddress(fromAccount), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionsFromAccountByBlockNumberRange(_reader, common.HexToAddress(fromAccount), _fromBlock, _toBlock); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -771,8 +1036,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsFromAccountByBlockTimeRange(_db, common.HexToAddress(fromAccount), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionsFromAccountByBlockTimeRange(_reader, common.HexToAddress(fromAccount), _fromTime, _toTime); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -795,8 +1060,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsToAccountByBlockNumberRange(_db, common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionsToAccountByBlockNumberRange(_reader, common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
 					return
 				}
 
@@ -819,8 +1084,106 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsToAccountByBlockTimeRange(_db, common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := db.GetTransactionsToAccountByBlockTimeRange(_reader, common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
+					respondWithJSON(decodeTransactions(c, tx).ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
+		// Internal tx(s) i.e. calls made by contracts during tx execution, fetch
+		// ( by query params ) request handler, available only when `ette` is
+		// tracing calls
+		grp.GET("/transaction/internal", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
+
+			hash := c.Query("hash")
+			blockHash := c.Query("blockHash")
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			account := c.Query("account")
+
+			// Given tx hash, returns all calls made during its execution
+			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
+
+				if txs := db.GetInternalTransactionsByTransactionHash(_reader, common.HexToHash(hash)); txs != nil {
+					respondWithJSON(txs.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block hash, returns all calls made during execution of tx(s) in block
+			if strings.HasPrefix(blockHash, "0x") && len(blockHash) == 66 {
+
+				if txs := db.GetInternalTransactionsByBlockHash(_reader, common.HexToHash(blockHash)); txs != nil {
+					respondWithJSON(txs.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block number range & account, returns all calls made from/ to that account
+			if fromBlock != "" && toBlock != "" && strings.HasPrefix(account, "0x") && len(account) == 42 {
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if txs := db.GetInternalTransactionsOfAccountByBlockNumberRange(_reader, common.HexToAddress(account), _fromBlock, _toBlock); txs != nil {
+					respondWithJSON(txs.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block time range & account, returns all calls made from/ to that account
+			if fromTime != "" && toTime != "" && strings.HasPrefix(account, "0x") && len(account) == 42 {
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				if txs := db.GetInternalTransactionsOfAccountByBlockTimeRange(_reader, common.HexToAddress(account), _fromTime, _toTime); txs != nil {
+					respondWithJSON(txs.ToJSON(), c)
 					return
 				}
 
@@ -876,8 +1239,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventByBlockHashAndLogIndex(_db, common.HexToHash(blockHash), uint(_logIndex)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetEventByBlockHashAndLogIndex(_reader, common.HexToHash(blockHash), uint(_logIndex)); event != nil {
+					respondWithJSON(decodeEvent(c, event).ToJSON(), c)
 					return
 				}
 
@@ -908,8 +1271,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventByBlockNumberAndLogIndex(_db, _blockNumber, uint(_logIndex)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetEventByBlockNumberAndLogIndex(_reader, _blockNumber, uint(_logIndex)); event != nil {
+					respondWithJSON(decodeEvent(c, event).ToJSON(), c)
 					return
 				}
 
@@ -923,8 +1286,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given blockhash, retrieves all events emitted by tx present in block
 			if strings.HasPrefix(blockHash, "0x") && len(blockHash) == 66 {
 
-				if event := db.GetEventsByBlockHash(_db, common.HexToHash(blockHash)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetEventsByBlockHash(_reader, common.HexToHash(blockHash)); event != nil {
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 				}
 
@@ -938,8 +1301,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given txhash, retrieves all events emitted by that tx ( i.e. during tx execution )
 			if strings.HasPrefix(txHash, "0x") && len(txHash) == 66 {
 
-				if event := db.GetEventsByTransactionHash(_db, common.HexToHash(txHash)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetEventsByTransactionHash(_reader, common.HexToHash(txHash)); event != nil {
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 				}
 
@@ -968,8 +1331,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetLastXEventsFromContract(_db, common.HexToAddress(contract), _count); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetLastXEventsFromContract(_reader, common.HexToAddress(contract), _count); event != nil {
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 				}
 
@@ -1004,9 +1367,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 				}
 
-				if event := db.GetEventsFromContractWithTopicsByBlockNumberRange(_db, common.HexToAddress(contract), _fromBlock, _toBlock, topics); event != nil {
+				if event := db.GetEventsFromContractWithTopicsByBlockNumberRange(_reader, common.HexToAddress(contract), _fromBlock, _toBlock, topics); event != nil {
 
-					respondWithJSON(event.ToJSON(), c)
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 
 				}
@@ -1042,9 +1405,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 				}
 
-				if event := db.GetEventsFromContractWithTopicsByBlockTimeRange(_db, common.HexToAddress(contract), _fromTime, _toTime, topics); event != nil {
+				if event := db.GetEventsFromContractWithTopicsByBlockTimeRange(_reader, common.HexToAddress(contract), _fromTime, _toTime, topics); event != nil {
 
-					respondWithJSON(event.ToJSON(), c)
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 
 				}
@@ -1067,8 +1430,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventsFromContractByBlockNumberRange(_db, common.HexToAddress(contract), _fromBlock, _toBlock); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetEventsFromContractByBlockNumberRange(_reader, common.HexToAddress(contract), _fromBlock, _toBlock); event != nil {
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 				}
 
@@ -1091,8 +1454,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventsFromContractByBlockTimeRange(_db, common.HexToAddress(contract), _fromTime, _toTime); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := db.GetEventsFromContractByBlockTimeRange(_reader, common.HexToAddress(contract), _fromTime, _toTime); event != nil {
+					respondWithJSON(decodeEvents(c, event).ToJSON(), c)
 					return
 				}
 
@@ -1109,12 +1472,486 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
-		// Returns how many clients are currently connected to
-		// `ette` over WS
-		grp.GET("/stat", func(c *gin.Context) {
+		// Query for token transfers, decoded from ERC-20, ERC-721 & ERC-1155
+		// standard transfer event logs
+		grp.GET("/transfer", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
 
-			c.JSON(http.StatusOK, gin.H{
-				"count": activeSubscriptions.Count,
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			token := c.Query("token")
+			holder := c.Query("holder")
+
+			validToken := strings.HasPrefix(token, "0x") && len(token) == 42
+			validHolder := strings.HasPrefix(holder, "0x") && len(holder) == 42
+
+			if !(validToken || validHolder) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad query param(s)",
+				})
+				return
+			}
+
+			// Given block number range, token and/ or holder address, finds out
+			// all token transfers satisfying criteria
+			if fromBlock != "" && toBlock != "" {
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				var transfers *d.TokenTransfers
+
+				switch {
+				case validToken && validHolder:
+					transfers = db.GetTokenTransfersOfHolderForTokenByBlockNumberRange(_reader, common.HexToAddress(token), common.HexToAddress(holder), _fromBlock, _toBlock)
+				case validToken:
+					transfers = db.GetTokenTransfersOfTokenByBlockNumberRange(_reader, common.HexToAddress(token), _fromBlock, _toBlock)
+				default:
+					transfers = db.GetTokenTransfersOfHolderByBlockNumberRange(_reader, common.HexToAddress(holder), _fromBlock, _toBlock)
+				}
+
+				if transfers != nil {
+					respondWithJSON(transfers.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block time span, token and/ or holder address, finds out
+			// all token transfers satisfying criteria
+			if fromTime != "" && toTime != "" {
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				var transfers *d.TokenTransfers
+
+				switch {
+				case validToken && validHolder:
+					transfers = db.GetTokenTransfersOfHolderForTokenByBlockTimeRange(_reader, common.HexToAddress(token), common.HexToAddress(holder), _fromTime, _toTime)
+				case validToken:
+					transfers = db.GetTokenTransfersOfTokenByBlockTimeRange(_reader, common.HexToAddress(token), _fromTime, _toTime)
+				default:
+					transfers = db.GetTokenTransfersOfHolderByBlockTimeRange(_reader, common.HexToAddress(holder), _fromTime, _toTime)
+				}
+
+				if transfers != nil {
+					respondWithJSON(transfers.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
+		// Uploads ABI of contract, to be used for decoding event logs emitted by
+		// it & tx(s) sent to it, replacing previous one, if any
+		grp.POST("/abi", validateAPIKey, func(c *gin.Context) {
+
+			var payload d.ABIPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad ABI Payload",
+				})
+				return
+			}
+
+			if !(strings.HasPrefix(payload.Address, "0x") && len(payload.Address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad contract address",
+				})
+				return
+			}
+
+			user := db.GetUserFromAPIKey(_db, c.GetHeader("APIKey"))
+			if user == nil {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Bad API Key",
+				})
+				return
+			}
+
+			if err := decoder.Register(_db, common.HexToAddress(payload.Address), payload.GetABI(), common.HexToAddress(user.Address)); err != nil {
+				if errors.Is(err, db.ErrNotUploader) {
+					c.JSON(http.StatusConflict, gin.H{
+						"msg": "ABI already uploaded by someone else",
+					})
+					return
+				}
+
+				log.Printf("[!] Failed to register contract ABI : %s\n", err.Error())
+
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad contract ABI",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Returns ABI of contract, if uploaded
+		grp.GET("/abi", validateAPIKey, func(c *gin.Context) {
+
+			address := c.Query("address")
+
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad query param(s)",
+				})
+				return
+			}
+
+			if abi := db.GetABI(_db, common.HexToAddress(address)); abi != nil {
+				if data := abi.ToJSON(); data != nil {
+					c.Data(http.StatusOK, "application/json", data)
+					return
+				}
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "JSON encoding failed",
+				})
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		// Registers webhook for app, to which matching real-time data published
+		// on topic is to be POST-ed
+		grp.POST("/webhook", validateAPIKey, func(c *gin.Context) {
+
+			var payload d.WebhookPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Webhook Payload",
+				})
+				return
+			}
+
+			registerWebhook(c, c.GetHeader("APIKey"), &payload)
+
+		})
+
+		// Returns all webhooks registered for app
+		grp.GET("/webhook", validateAPIKey, func(c *gin.Context) {
+
+			if webhooks := db.GetWebhooksByAPIKey(_db, c.GetHeader("APIKey")); webhooks != nil {
+				c.JSON(http.StatusOK, gin.H{
+					"webhooks": webhooks,
+				})
+				return
+			}
+
+			c.JSON(http.StatusNoContent, gin.H{
+				"msg": "No webhooks registered yet",
+			})
+
+		})
+
+		// Deletes webhook, if it's registered for any of apps created by
+		// same user
+		grp.DELETE("/webhook", validateAPIKey, func(c *gin.Context) {
+
+			user := db.GetUserFromAPIKey(_db, c.GetHeader("APIKey"))
+			if user == nil {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Bad API Key",
+				})
+				return
+			}
+
+			if !db.DeleteWebhook(_db, c.Query("id"), common.HexToAddress(user.Address)) {
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Returns latest dead letters of webhook i.e. data which couldn't
+		// be delivered, even after retrying
+		grp.GET("/webhook/dead", validateAPIKey, func(c *gin.Context) {
+
+			user := db.GetUserFromAPIKey(_db, c.GetHeader("APIKey"))
+			if user == nil {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Bad API Key",
+				})
+				return
+			}
+
+			respondWithDeadLetters(c, c.Query("id"), common.HexToAddress(user.Address))
+
+		})
+
+		// Admin logs in by signing message using `Admin` address set in `.env`
+		// file, same as user login, but session is valid only for `/v1/admin/*`
+		grp.POST("/admin/login", func(c *gin.Context) {
+
+			var payload d.AuthPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Authentication Payload",
+				})
+				return
+			}
+
+			signer := payload.RecoverSigner()
+
+			if !payload.VerifySignature(signer) {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Verification Failed",
+				})
+				return
+			}
+
+			if payload.HasExpired(30) {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Signature Expired",
+				})
+				return
+			}
+
+			if !payload.IsAdmin(signer) {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Not Admin",
+				})
+				return
+			}
+
+			if _, err := _redisClient.Set(context.Background(), payload.Signature, payload.Message.Address.Hex(), time.Duration(3600)*time.Second).Result(); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Something went wrong",
+				})
+				return
+			}
+
+			c.SetCookie("AdminSessionID", payload.Signature, 3600, "/v1/admin", cfg.Get("Domain"), false, true)
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Lists all blocks present in block processor queue, along with their
+		// state & when they'll be attempted next, optionally filtered by state
+		grp.GET("/admin/queue", validateAdminSessionID, func(c *gin.Context) {
+
+			state := c.Query("state")
+			blocks := _queue.List()
+
+			if state != "" {
+
+				filtered := make([]*q.QueuedBlock, 0, len(blocks))
+				for _, v := range blocks {
+
+					if v.State == state {
+						filtered = append(filtered, v)
+					}
+
+				}
+
+				blocks = filtered
+
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"blocks": blocks,
+			})
+
+		})
+
+		// Makes waiting block eligible for processing right now
+		grp.POST("/admin/queue/retry", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.QueueBlockPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Block Payload",
+				})
+				return
+			}
+
+			if !_queue.Retry(*payload.Number) {
+				c.JSON(http.StatusConflict, gin.H{
+					"msg": "Block not waiting in queue",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Resets backoff of block, back to 1 second
+		grp.POST("/admin/queue/reset", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.QueueBlockPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Block Payload",
+				})
+				return
+			}
+
+			if !_queue.ResetDelay(*payload.Number) {
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Block not in queue",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Removes block from queue, so that it's not attempted anymore
+		grp.POST("/admin/queue/drop", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.QueueBlockPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Block Payload",
+				})
+				return
+			}
+
+			if !_queue.Drop(*payload.Number) {
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Block not in queue",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Puts all blocks in given range into queue, wiping out their
+		// history, if any, so that they get processed again from scratch,
+		// without being published to real-time subscribers
+		//
+		// Range can't be wider than `BlockRange` & can't go beyond latest
+		// block seen, so that queue isn't flooded
+		grp.POST("/admin/queue/enqueue", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.QueueRangePayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Range Payload",
+				})
+				return
+			}
+
+			if !(payload.From <= payload.To && payload.To-payload.From < cfg.GetBlockNumberRange() && payload.To <= _status.GetLatestBlockNumber()) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad block range",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg":   "Success",
+				"count": _queue.RequeueRange(payload.From, payload.To),
+			})
+
+		})
+
+		// Fetches blocks in range afresh, compares them with what's persisted
+		// & replaces mismatches, responding with report of what was changed
+		//
+		// Only blocks having required confirmations can be re-indexed
+		grp.POST("/admin/reindex", checkEtteHistoricalMode, validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.QueueRangePayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Range Payload",
+				})
+				return
+			}
+
+			latest := _status.GetLatestBlockNumber()
+
+			if !(payload.From <= payload.To && payload.To-payload.From < cfg.GetBlockNumberRange() && latest >= cfg.GetBlockConfirmations() && payload.To <= latest-cfg.GetBlockConfirmations()) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad block range",
+				})
+				return
+			}
+
+			data := blk.Reindex(_connection, _db, payload.From, payload.To, _status).ToJSON()
+			if data == nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "JSON encoding failed",
+				})
+				return
+			}
+
+			c.Data(http.StatusOK, "application/json", data)
+
+		})
+
+		// Returns how many clients are currently connected to
+		// `ette` over WS
+		grp.GET("/stat", func(c *gin.Context) {
+
+			c.JSON(http.StatusOK, gin.H{
+				"count": activeSubscriptions.Count,
 			})
 
 		})
@@ -1175,7 +2012,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		pubsubManager := ps.SubscriptionManager{
 			Topics:     make(map[string]map[string]*ps.SubscriptionRequest),
 			Consumers:  make(map[string]ps.Consumer),
-			Client:     _redisClient,
+			Broker:     _broker,
 			Connection: conn,
 			DB:         _db,
 			ConnLock:   &connLock,
@@ -1183,18 +2020,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			Counter:    &sendReceiveCounter,
 		}
 
-		// Unsubscribe from all pubsub topics ( 3 at max ) when returning from
+		// Unsubscribe from all pubsub topics ( 6 at max ) when returning from
 		// this execution scope
-		defer func() {
-
-			topicLock.Lock()
-			defer topicLock.Unlock()
-
-			for _, v := range pubsubManager.Consumers {
-				v.Unsubscribe()
-			}
-
-		}()
+		defer pubsubManager.UnsubscribeAll()
 
 		// Client communication handling logic
 		for {
@@ -1292,6 +2120,149 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 	})
 
+	// Server-Sent Events based real-time data delivery, for clients which can't
+	// speak websocket protocol, where topic is passed as query param, following
+	// same format as websocket subscription request, along with same filters
+	//
+	// Each event carries ID, which client sends back in `Last-Event-ID` header,
+	// when reconnecting, so that it resumes right after last event it received,
+	// as long as broker still keeps it, while heartbeats are sent as comments,
+	// when nothing is delivered for a while, so that idle connection isn't
+	// dropped by proxies in between
+	router.GET("/v1/sse", validateAPIKey, func(c *gin.Context) {
+
+		if !cfg.IsRealtime() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Disabled Feature",
+			})
+			return
+		}
+
+		req := ps.SubscriptionRequest{
+			Name:   c.Query("topic"),
+			Type:   "subscribe",
+			APIKey: c.GetHeader("APIKey"),
+		}
+
+		if !req.IsValidTopic() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad topic",
+			})
+			return
+		}
+
+		var sub broker.Subscription
+		var err error
+
+		if last := c.GetHeader("Last-Event-ID"); last != "" {
+			sub, err = _broker.SubscribeAfter(req.Topic(), last)
+		} else {
+			sub, err = _broker.Subscribe(req.Topic())
+		}
+
+		if err == broker.ErrBadID {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad Last-Event-ID",
+			})
+			return
+		}
+
+		if err != nil {
+			log.Printf("[!] Failed to subscribe to `%s` topic : %s\n", req.Topic(), err.Error())
+
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"msg": "Failed to subscribe",
+			})
+			return
+		}
+
+		// Unsubscribing from topic, when client goes away
+		defer sub.Close()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		// Asking reverse proxy not to buffer events
+		c.Header("X-Accel-Buffering", "no")
+
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		// Lets client know why no more events are going to be sent,
+		// before closing connection
+		fail := func(msg string) {
+			if err := sse.Encode(c.Writer, sse.Event{Event: "error", Data: ps.SubscriptionResponse{Code: 0, Message: msg}}); err != nil {
+				log.Printf("[!] Failed to write event : %s\n", err.Error())
+			}
+
+			c.Writer.Flush()
+		}
+
+		lastWrite := time.Now()
+
+		for {
+
+			select {
+			case <-c.Request.Context().Done():
+				return
+			default:
+			}
+
+			msg, err := sub.Next(time.Second)
+			switch err {
+
+			case nil:
+
+			case broker.ErrTimeout:
+
+				if time.Since(lastWrite) < sseHeartbeatInterval {
+					continue
+				}
+
+				if _, err := c.Writer.WriteString(": heartbeat\n\n"); err != nil {
+					return
+				}
+
+				c.Writer.Flush()
+				lastWrite = time.Now()
+				continue
+
+			default:
+				return
+
+			}
+
+			if !req.DoesMatchWithPublishedData(msg) {
+				continue
+			}
+
+			// API key being checked before every delivery, same as websocket
+			// subscriptions, because it can be disabled any time
+			user := req.GetUserFromAPIKey(_db)
+			if user == nil || !user.Enabled {
+				fail("Bad API Key")
+				return
+			}
+
+			if !req.IsUnderRateLimit(_db, common.HexToAddress(user.Address)) {
+				fail("Crossed Allowed Rate Limit")
+				return
+			}
+
+			if err := sse.Encode(c.Writer, sse.Event{Id: sub.LastID(), Event: req.Topic(), Data: msg}); err != nil {
+				log.Printf("[!] Failed to write event : %s\n", err.Error())
+				return
+			}
+
+			c.Writer.Flush()
+			lastWrite = time.Now()
+
+			db.PutDataDeliveryInfo(_db, user.Address, fmt.Sprintf("/v1/sse/%s", req.Topic()), uint64(len(msg)))
+
+		}
+
+	})
+
 	router.POST("/v1/graphql", validateAPIKey,
 		// Attempting to pass router context, which holds `APIKey`
 		// to graphql handler, so that some accounting job can
@@ -1,32 +1,61 @@
 package services
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/gookit/color"
+	cfg "github.com/itzmeanjan/ette/app/config"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
 	"gorm.io/gorm"
 )
 
 // DeliveryHistoryCleanUpService - This function is supposed to be run as
-// an independent go routine, which will invoke self every 24 hours &
-// attempt to clean up all delivery histories for all clients
-// older than recent 24 hours
-func DeliveryHistoryCleanUpService(_db *gorm.DB) {
+// an independent go routine, which will attempt to clean up all delivery
+// histories for all clients older than recent 24 hours, along with webhook
+// dead letters older than 7 days, as soon as it's started & then every 24 hours
+func DeliveryHistoryCleanUpService(ctx context.Context, _db *gorm.DB) {
+
+	cleanUp := func() {
+
+		log.Print(color.Green.Sprintf("[*] Attempting to clean delivery history older than 24 hours"))
+
+		deleted, err := db.DropOldDeliveryHistories(_db, int(cfg.GetRetentionBatchSize()))
+		metrics.Pruned("delivery_history", deleted)
+
+		if err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to clean delivery history : %s", err.Error()))
+			return
+		}
+
+		log.Print(color.Green.Sprintf("[+] Cleaned delivery history older than 24 hours [ Deleted : %d ]", deleted))
+
+		deleted, err = db.DropOldWebhookDeadLetters(_db, int(cfg.GetRetentionBatchSize()))
+		metrics.Pruned("webhook_dead_letters", deleted)
+
+		if err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to clean webhook dead letters : %s", err.Error()))
+			return
+		}
+
+		log.Print(color.Green.Sprintf("[+] Cleaned webhook dead letters older than 7 days [ Deleted : %d ]", deleted))
+
+	}
+
+	cleanUp()
 
 	for {
 
 		select {
 
+		case <-ctx.Done():
+			return
+
 		// This syntax invokes following function 24 hours later, last time it completed its job
 		case <-time.After(time.Hour * time.Duration(24)):
-
-			log.Printf(color.Green.Sprintf("[*] Attempting to clean delivery history older than 24 hours"))
-
-			db.DropOldDeliveryHistories(_db)
-
-			log.Printf(color.Green.Sprintf("[+] Cleaned delivery history older than 24 hours"))
+			cleanUp()
 
 		}
 
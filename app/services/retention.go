@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gookit/color"
+	blk "github.com/itzmeanjan/ette/app/block"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	"gorm.io/gorm"
+)
+
+// RetentionCutOff - Lowest block number to be retained, as per `StartBlock`,
+// `HistoryWindow` & `RetentionDays`, whichever is highest, while latest
+// block is always retained
+func RetentionCutOff(connection *d.BlockChainNodeConnection) (uint64, bool) {
+
+	client := connection.RPC.Get()
+	if client == nil {
+		return 0, false
+	}
+
+	latest, err := client.BlockNumber(context.Background())
+	if err != nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to fetch latest block number : %s", err.Error()))
+		connection.RPC.Failed(client)
+		return 0, false
+
+	}
+
+	cutOff := cfg.GetFirstBlock(latest)
+
+	if days := cfg.GetRetentionDays(); days != 0 {
+
+		since := time.Now().UTC().Add(-time.Duration(days) * time.Duration(24) * time.Hour)
+
+		first, ok := blk.FirstBlockSince(connection, uint64(since.Unix()), latest)
+		if !ok {
+			return 0, false
+		}
+
+		if first > cutOff {
+			cutOff = first
+		}
+
+	}
+
+	if cutOff > latest {
+		cutOff = latest
+	}
+
+	return cutOff, true
+
+}
+
+// pruneBlocks - Removes all blocks before cut off, batch by batch, starting
+// from oldest one, so that tables don't stay locked for long
+func pruneBlocks(_db *gorm.DB, status *d.StatusHolder, cutOff uint64, report *d.PruneReport) bool {
+
+	for {
+
+		// Looked up every time, so that gaps are skipped
+		from := db.GetCurrentOldestBlockNumber(_db)
+		if from >= cutOff {
+			return true
+		}
+
+		to := from + cfg.GetRetentionBatchSize() - 1
+		if to >= cutOff || to < from {
+			to = cutOff - 1
+		}
+
+		_report, err := db.PruneBlocks(_db, from, to)
+		if err != nil {
+
+			log.Print(color.Red.Sprintf("[!] Failed to prune blocks [ %d - %d ] : %s", from, to, err.Error()))
+			return false
+
+		}
+
+		report.Add(_report)
+		status.AddBlocksRemoved(uint64(_report.Blocks))
+
+		// Database is empty
+		if _report.Blocks == 0 {
+			return true
+		}
+
+	}
+
+}
+
+// pruneEvents - Removes event logs not emitted by any of contracts, from blocks
+// in [from, to] range, batch by batch, returning upto which block it's done
+func pruneEvents(_db *gorm.DB, contracts []string, from uint64, to uint64, report *d.PruneReport) uint64 {
+
+	for i := from; i <= to; i += cfg.GetRetentionBatchSize() {
+
+		_to := i + cfg.GetRetentionBatchSize() - 1
+		if _to > to || _to < i {
+			_to = to
+		}
+
+		_report, err := db.PruneEvents(_db, i, _to, contracts)
+		if err != nil {
+
+			log.Print(color.Red.Sprintf("[!] Failed to prune event logs [ %d - %d ] : %s", i, _to, err.Error()))
+			return i
+
+		}
+
+		report.Add(_report)
+
+		if _to == to {
+			break
+		}
+
+	}
+
+	return to + 1
+
+}
+
+// RetentionService - Keeps pruning blocks falling out of retention policies,
+// along with all of their rows & event logs not emitted by contracts to be
+// retained, as soon as it's started & then every `RetentionInterval` seconds,
+// reporting what got removed
+//
+// Cut off is to be decided once & set in status, before block syncers get
+// started, so that pruned blocks are not fetched again
+//
+// To be run as an independent go routine
+func RetentionService(ctx context.Context, connection *d.BlockChainNodeConnection, _db *gorm.DB, status *d.StatusHolder) {
+
+	contracts := make([]string, 0)
+	for _, v := range cfg.GetRetentionContracts() {
+		contracts = append(contracts, common.HexToAddress(v).Hex())
+	}
+
+	// Blocks before it are already pruned off non-retained event logs
+	var eventsPrunedUpto uint64
+
+	prune := func() {
+
+		start := time.Now().UTC()
+
+		cutOff, ok := RetentionCutOff(connection)
+		if !ok {
+
+			log.Print(color.Red.Sprintf("[!] Failed to decide retention cut off"))
+			return
+
+		}
+
+		status.SetRetainedFrom(cutOff)
+
+		report := &d.PruneReport{}
+
+		if !pruneBlocks(_db, status, cutOff, report) {
+			return
+		}
+
+		if len(contracts) != 0 {
+
+			if eventsPrunedUpto < cutOff {
+				eventsPrunedUpto = cutOff
+			}
+
+			if latest := db.GetCurrentBlockNumber(_db); eventsPrunedUpto <= latest {
+				eventsPrunedUpto = pruneEvents(_db, contracts, eventsPrunedUpto, latest, report)
+			}
+
+		}
+
+		metrics.Pruned("blocks", report.Blocks)
+		metrics.Pruned("transactions", report.Transactions)
+		metrics.Pruned("events", report.Events)
+		metrics.Pruned("token_transfers", report.TokenTransfers)
+		metrics.Pruned("internal_transactions", report.InternalTransactions)
+
+		if report.Empty() {
+			return
+		}
+
+		log.Print(color.Green.Sprintf("[+] Pruned blocks before %d [ Blocks : %d, Transactions : %d, Events : %d, Token Transfers : %d, Internal Transactions : %d, Took : %s ]", cutOff, report.Blocks, report.Transactions, report.Events, report.TokenTransfers, report.InternalTransactions, time.Now().UTC().Sub(start)))
+
+	}
+
+	prune()
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-time.After(time.Duration(cfg.GetRetentionInterval()) * time.Second):
+			prune()
+
+		}
+
+	}
+
+}
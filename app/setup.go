@@ -1,11 +1,11 @@
 package app
 
 import (
-	"context"
 	"log"
 	"sync"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/itzmeanjan/ette/app/broker"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
@@ -14,17 +14,23 @@ import (
 	"gorm.io/gorm"
 )
 
-// Setting ground up i.e. acquiring resources required & determining with
-// some basic checks whether we can proceed to next step or not
-func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConnection, *redis.Client, *d.RedisInfo, *gorm.DB, *d.StatusHolder, *q.BlockProcessorQueue) {
+// readConfig - Reads config file, which must be done before anything else
+func readConfig(configFile string) {
 
-	err := cfg.Read(configFile)
-	if err != nil {
-		log.Fatalf("[!] Failed to read `.env` : %s\n", err.Error())
+	if err := cfg.Read(configFile); err != nil {
+		log.Fatalf("[!] Failed to read `%s` : %s\n", configFile, err.Error())
 	}
 
-	if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "2" || cfg.Get("EtteMode") == "3" || cfg.Get("EtteMode") == "4" || cfg.Get("EtteMode") == "5") {
-		log.Fatalf("[!] Failed to find `EtteMode` in configuration file\n")
+}
+
+// Setting ground up i.e. acquiring resources required & determining with
+// some basic checks whether we can proceed to next step or not
+//
+// Config file must be read before invoking it
+func bootstrap(subscriptionPlansFile string) (*d.BlockChainNodeConnection, *redis.Client, *d.RedisInfo, *gorm.DB, *d.StatusHolder, *q.BlockProcessorQueue) {
+
+	if !(cfg.IsHistorical() || cfg.IsRealtime()) {
+		log.Fatalf("[!] Neither historical nor real-time mode enabled, set `EtteMode` in configuration file\n")
 	}
 
 	// Maintaining both HTTP & Websocket based connection to blockchain
@@ -39,19 +45,16 @@ func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConne
 		log.Fatalf("[!] Failed to connect to Redis Server\n")
 	}
 
-	if err := _redisClient.FlushAll(context.Background()).Err(); err != nil {
-		log.Printf("[!] Failed to flush all keys from redis : %s\n", err.Error())
-	}
-
-	_db := db.Connect()
+	_db := db.Connect(cfg.GetReplicaURLs()...)
 
 	// Populating subscription plans from `.plans.json` into
 	// database table, at application start up
 	db.PersistAllSubscriptionPlans(_db, subscriptionPlansFile)
 
 	// Passing db handle, to graph package, so that it can be used
-	// for resolving graphQL queries
-	graph.GetDatabaseConnection(_db)
+	// for resolving graphQL queries, where read only queries are
+	// routed to read replicas, if any
+	graph.GetDatabaseConnection(_db, db.Reader(_db))
 
 	_status := &d.StatusHolder{
 		State: &d.SyncState{
@@ -61,15 +64,25 @@ func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConne
 		Mutex: &sync.RWMutex{},
 	}
 
+	_broker, err := broker.New(_redisClient)
+	if err != nil {
+		log.Fatalf("[!] Failed to set up broker : %s\n", err.Error())
+	}
+
 	_redisInfo := &d.RedisInfo{
-		Client:            _redisClient,
-		BlockPublishTopic: "block",
-		TxPublishTopic:    "transaction",
-		EventPublishTopic: "event",
+		Client:               _redisClient,
+		Broker:               _broker,
+		BlockPublishTopic:    "block",
+		TxPublishTopic:       "transaction",
+		EventPublishTopic:    "event",
+		ReorgPublishTopic:    "reorg",
+		TransferPublishTopic: "transfer",
+		PendingPublishTopic:  "pending",
 	}
 
-	// This is block processor queue
-	_queue := q.New(db.GetCurrentBlockNumber(_db))
+	// This is block processor queue, whose state is kept in database, so
+	// that blocks being processed are not forgotten across restarts
+	_queue := q.New(db.GetCurrentBlockNumber(_db), &db.QueueStore{DB: _db})
 
 	return _connection, _redisClient, _redisInfo, _db, _status, _queue
 
@@ -1,6 +1,9 @@
 package snapshot
 
 import (
+	"encoding/json"
+	"log"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
@@ -29,6 +32,10 @@ func BlockToProtoBuf(block *data.Block, db *gorm.DB) *pb.Block {
 		TransactionRootHash: block.TransactionRootHash,
 		ReceiptRootHash:     block.ReceiptRootHash,
 		ExtraData:           block.ExtraData,
+		BaseFee:             block.BaseFee,
+		BlobGasUsed:         block.BlobGasUsed,
+		ExcessBlobGas:       block.ExcessBlobGas,
+		Withdrawals:         WithdrawalsToProtoBuf(block.Withdrawals),
 	}
 
 	txs := _db.GetTransactionsByBlockHash(db, common.HexToHash(block.Hash))
@@ -61,6 +68,10 @@ func ProtoBufToBlock(block *pb.Block) *_db.PackedBlock {
 		TransactionRootHash: block.TransactionRootHash,
 		ReceiptRootHash:     block.ReceiptRootHash,
 		ExtraData:           block.ExtraData,
+		BaseFee:             block.BaseFee,
+		BlobGasUsed:         block.BlobGasUsed,
+		ExcessBlobGas:       block.ExcessBlobGas,
+		Withdrawals:         ProtoBufToWithdrawals(block.Withdrawals),
 	}
 
 	if block.Transactions == nil {
@@ -69,9 +80,82 @@ func ProtoBufToBlock(block *pb.Block) *_db.PackedBlock {
 		}
 	}
 
+	txs := ProtoBufToTransactions(block.Transactions)
+
+	// Block number isn't kept along with tx(s) & event logs in
+	// snapshot, rather it's taken from block they belong to
+	for _, t := range txs {
+
+		t.Tx.BlockNumber = block.Number
+
+		for _, e := range t.Events {
+			e.BlockNumber = block.Number
+		}
+
+	}
+
 	return &_db.PackedBlock{
 		Block:        _block,
-		Transactions: ProtoBufToTransactions(block.Transactions),
+		Transactions: txs,
+	}
+
+}
+
+// WithdrawalsToProtoBuf - Converting JSON encoded withdrawals, as persisted
+// in database, to proto buffer compatible data format
+func WithdrawalsToProtoBuf(withdrawals []byte) []*pb.Withdrawal {
+
+	_withdrawals := data.DecodeWithdrawals(withdrawals)
+	_pb := make([]*pb.Withdrawal, len(_withdrawals))
+
+	for k, v := range _withdrawals {
+
+		_pb[k] = &pb.Withdrawal{
+			Index:     v.Index,
+			Validator: v.Validator,
+			Address:   v.Address,
+			Amount:    v.Amount,
+		}
+
 	}
 
+	return _pb
+
+}
+
+// ProtoBufToWithdrawals - Converting withdrawals from snapshot back
+// into JSON encoded form, to be persisted in database
+//
+// Snapshot can't tell blocks having no withdrawals apart from ones prior
+// to Shanghai fork, so both are restored as nil, which is considered same
+// as empty list, when comparing blocks
+func ProtoBufToWithdrawals(withdrawals []*pb.Withdrawal) []byte {
+
+	if len(withdrawals) == 0 {
+		return nil
+	}
+
+	_withdrawals := make([]*data.Withdrawal, len(withdrawals))
+
+	for k, v := range withdrawals {
+
+		_withdrawals[k] = &data.Withdrawal{
+			Index:     v.Index,
+			Validator: v.Validator,
+			Address:   v.Address,
+			Amount:    v.Amount,
+		}
+
+	}
+
+	_data, err := json.Marshal(_withdrawals)
+	if err != nil {
+
+		log.Printf("[!] Failed to encode withdrawals : %s\n", err.Error())
+		return nil
+
+	}
+
+	return _data
+
 }
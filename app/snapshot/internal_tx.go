@@ -0,0 +1,64 @@
+package snapshot
+
+import (
+	"github.com/itzmeanjan/ette/app/data"
+	_db "github.com/itzmeanjan/ette/app/db"
+	pb "github.com/itzmeanjan/ette/app/pb"
+)
+
+// InternalTransactionsToProtoBuf - Creating proto buffer compatible data
+// format for internal tx(s), which can be easily serialized & deserialized
+// for taking snapshot and restoring from it
+func InternalTransactionsToProtoBuf(txs *data.InternalTransactions) []*pb.InternalTransaction {
+
+	_txs := make([]*pb.InternalTransaction, len(txs.InternalTransactions))
+
+	for k, v := range txs.InternalTransactions {
+
+		_txs[k] = &pb.InternalTransaction{
+			Index:    uint32(v.Index),
+			Depth:    uint32(v.Depth),
+			Type:     v.Type,
+			From:     v.From,
+			To:       v.To,
+			Contract: v.Contract,
+			Value:    v.Value,
+			Gas:      v.Gas,
+			GasUsed:  v.GasUsed,
+			Error:    v.Error,
+		}
+
+	}
+
+	return _txs
+
+}
+
+// ProtoBufToInternalTransactions - Required while restoring from snapshot i.e. attempting to put
+// whole block data into database, where tx & block hash are taken from parent tx
+func ProtoBufToInternalTransactions(tx *pb.Transaction) []*_db.InternalTransactions {
+
+	_txs := make([]*_db.InternalTransactions, len(tx.InternalTransactions))
+
+	for k, v := range tx.InternalTransactions {
+
+		_txs[k] = &_db.InternalTransactions{
+			TransactionHash: tx.Hash,
+			Index:           uint(v.Index),
+			Depth:           uint(v.Depth),
+			Type:            v.Type,
+			From:            v.From,
+			To:              v.To,
+			Contract:        v.Contract,
+			Value:           v.Value,
+			Gas:             v.Gas,
+			GasUsed:         v.GasUsed,
+			Error:           v.Error,
+			BlockHash:       tx.BlockHash,
+		}
+
+	}
+
+	return _txs
+
+}
@@ -23,8 +23,32 @@ import (
 // using multiple workers
 //
 // Workers to be hired from worker pool of specific size.
+//
+// Blocks before first one to be indexed, as per `StartBlock` & `HistoryWindow`,
+// are skipped, where history window is counted back from latest block, either
+// present in snapshot or in database
 func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
 
+	var latest uint64
+
+	// Snapshot needs to be read once more, for finding out latest block
+	// in it, only when history window is to be applied
+	if cfg.GetHistoryWindow() != 0 {
+
+		highest, ok := HighestBlockInSnapshot(file)
+		if !ok {
+			return false, 0
+		}
+
+		latest = _db.GetCurrentBlockNumber(db)
+		if highest > latest {
+			latest = highest
+		}
+
+	}
+
+	first := cfg.GetFirstBlock(latest)
+
 	// Opening file in read only mode
 	fd, err := os.OpenFile(file, os.O_RDONLY, 0644)
 	if err != nil {
@@ -93,7 +117,7 @@ func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
 		func(_data []byte) {
 
 			pool.Submit(func() {
-				ProcessBlock(db, _data, control)
+				ProcessBlock(db, _data, first, control)
 			})
 
 		}(data)
@@ -124,7 +148,7 @@ func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
 //
 // Also letting coordinator go routine know that this worker
 // has completed its job
-func ProcessBlock(db *gorm.DB, data []byte, control chan bool) {
+func ProcessBlock(db *gorm.DB, data []byte, first uint64, control chan bool) {
 
 	block := UnmarshalData(data)
 	if block == nil {
@@ -132,6 +156,13 @@ func ProcessBlock(db *gorm.DB, data []byte, control chan bool) {
 		return
 	}
 
+	// Blocks before first one to be indexed are not to be
+	// restored, so they're skipped
+	if block.Number < first {
+		control <- true
+		return
+	}
+
 	// attempting to create data struct of format which can be
 	// easily used for persisting whole block data into DB
 	_block := ProtoBufToBlock(block)
@@ -148,6 +179,61 @@ func ProcessBlock(db *gorm.DB, data []byte, control chan bool) {
 
 }
 
+// HighestBlockInSnapshot - Reads whole snapshot file, for finding out highest
+// block number present in it, because blocks are not written in order
+func HighestBlockInSnapshot(file string) (uint64, bool) {
+
+	fd, err := os.OpenFile(file, os.O_RDONLY, 0644)
+	if err != nil {
+
+		log.Printf("[!] Error : %s\n", err.Error())
+		return 0, false
+
+	}
+
+	defer fd.Close()
+
+	var highest uint64
+
+	for {
+
+		buf := make([]byte, 4)
+
+		if _, err := io.ReadFull(fd, buf); err != nil {
+
+			if err == io.EOF {
+				break
+			}
+
+			log.Printf("[!] Failed to read chunk size : %s\n", err.Error())
+			return 0, false
+
+		}
+
+		data := make([]byte, binary.LittleEndian.Uint32(buf))
+
+		if _, err := io.ReadFull(fd, data); err != nil {
+
+			log.Printf("[!] Failed to read chunk : %s\n", err.Error())
+			return 0, false
+
+		}
+
+		block := UnmarshalData(data)
+		if block == nil {
+			return 0, false
+		}
+
+		if block.Number > highest {
+			highest = block.Number
+		}
+
+	}
+
+	return highest, true
+
+}
+
 // UnmarshalData - Given byte array attempts to deserialize
 // that into structured block data, which will be attempted to be
 // written into DB
@@ -1,7 +1,11 @@
 package snapshot
 
 import (
+	"encoding/json"
+	"log"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/itzmeanjan/ette/app/block"
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
 	pb "github.com/itzmeanjan/ette/app/pb"
@@ -26,6 +30,22 @@ func TransactionToProtoBuf(tx *data.Transaction, db *gorm.DB) *pb.Transaction {
 		Nonce:     tx.Nonce,
 		State:     tx.State,
 		BlockHash: tx.BlockHash,
+
+		Type:                 uint32(tx.Type),
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		EffectiveGasPrice:    tx.EffectiveGasPrice,
+		GasUsed:              tx.GasUsed,
+		AccessList:           AccessListToProtoBuf(tx.AccessList),
+		BlobGas:              tx.BlobGas,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		BlobGasPrice:         tx.BlobGasPrice,
+		BlobHashes:           tx.BlobHashes,
+	}
+
+	// Present only when `ette` was tracing calls, while processing block
+	if internalTxs := _db.GetInternalTransactionsByTransactionHash(db, common.HexToHash(tx.Hash)); internalTxs != nil {
+		_tx.InternalTransactions = InternalTransactionsToProtoBuf(internalTxs)
 	}
 
 	events := _db.GetEventsByTransactionHash(db, common.HexToHash(tx.Hash))
@@ -70,17 +90,35 @@ func ProtoBufToTransaction(tx *pb.Transaction) *_db.PackedTransaction {
 		Nonce:     tx.Nonce,
 		State:     tx.State,
 		BlockHash: tx.BlockHash,
+
+		Type:                 uint8(tx.Type),
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		EffectiveGasPrice:    tx.EffectiveGasPrice,
+		GasUsed:              tx.GasUsed,
+		AccessList:           ProtoBufToAccessList(tx.Type, tx.AccessList),
+		BlobGas:              tx.BlobGas,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		BlobGasPrice:         tx.BlobGasPrice,
+		BlobHashes:           tx.BlobHashes,
 	}
 
 	if tx.Events == nil {
 		return &_db.PackedTransaction{
-			Tx: _tx,
+			Tx:                   _tx,
+			InternalTransactions: ProtoBufToInternalTransactions(tx),
 		}
 	}
 
+	events := ProtoBufToEvents(tx.Events)
+
+	// Token transfers aren't kept in snapshot, rather
+	// decoded again from event logs
 	return &_db.PackedTransaction{
-		Tx:     _tx,
-		Events: ProtoBufToEvents(tx.Events),
+		Tx:                   _tx,
+		Events:               events,
+		TokenTransfers:       block.BuildPackedTokenTransfers(events),
+		InternalTransactions: ProtoBufToInternalTransactions(tx),
 	}
 
 }
@@ -100,3 +138,61 @@ func ProtoBufToTransactions(txs []*pb.Transaction) []*_db.PackedTransaction {
 	return _txs
 
 }
+
+// AccessListToProtoBuf - Converting JSON encoded access list, as persisted
+// in database, to proto buffer compatible data format
+func AccessListToProtoBuf(accessList []byte) []*pb.AccessTuple {
+
+	_accessList := data.DecodeAccessList(accessList)
+	_pb := make([]*pb.AccessTuple, len(_accessList))
+
+	for k, v := range _accessList {
+
+		_pb[k] = &pb.AccessTuple{
+			Address:     v.Address,
+			StorageKeys: v.StorageKeys,
+		}
+
+	}
+
+	return _pb
+
+}
+
+// ProtoBufToAccessList - Converting access list from snapshot back into
+// JSON encoded form, to be persisted in database
+//
+// Only tx(s) of type >= 1 i.e. EIP-2930 onwards, are having access list
+func ProtoBufToAccessList(txType uint32, accessList []*pb.AccessTuple) []byte {
+
+	if txType == 0 {
+		return nil
+	}
+
+	_accessList := make([]*data.AccessTuple, len(accessList))
+
+	for k, v := range accessList {
+
+		keys := v.StorageKeys
+		if keys == nil {
+			keys = make([]string, 0)
+		}
+
+		_accessList[k] = &data.AccessTuple{
+			Address:     v.Address,
+			StorageKeys: keys,
+		}
+
+	}
+
+	_data, err := json.Marshal(_accessList)
+	if err != nil {
+
+		log.Printf("[!] Failed to encode access list : %s\n", err.Error())
+		return nil
+
+	}
+
+	return _data
+
+}
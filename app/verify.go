@@ -0,0 +1,170 @@
+package app
+
+import (
+	"log"
+
+	"github.com/gookit/color"
+	blk "github.com/itzmeanjan/ette/app/block"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"gorm.io/gorm"
+)
+
+// verifyEndpoints - Reports health of all blockchain node endpoints in pool,
+// returns true if at least one of them is healthy
+func verifyEndpoints(kind string, pool *d.EndpointPool) bool {
+
+	healthy := 0
+
+	for _, v := range pool.Endpoints {
+
+		if !v.Healthy {
+			log.Print(color.Red.Sprintf("[!] %s endpoint `%s` unhealthy", kind, v.URL))
+			continue
+		}
+
+		healthy++
+		log.Print(color.Green.Sprintf("[+] %s endpoint `%s` healthy [ Head : %d, Latency : %s ]", kind, v.URL, v.Head, v.Latency))
+
+	}
+
+	return healthy != 0
+
+}
+
+// verifyIntegrity - Verifies integrity of persisted blocks in [from, to] range,
+// chunk by chunk, while recording findings in database & reporting them, returns
+// true if nothing's wrong
+func verifyIntegrity(connection *d.BlockChainNodeConnection, _db *gorm.DB, from uint64, to uint64, samples uint64) bool {
+
+	var count int
+	var verified int
+
+	for i := from; i <= to; i += blk.IntegrityChunkSize {
+
+		toShouldbe := i + blk.IntegrityChunkSize - 1
+		if toShouldbe > to {
+			toShouldbe = to
+		}
+
+		findings, _verified, ok := blk.VerifyIntegrity(connection, _db, i, toShouldbe, samples)
+		if !ok {
+
+			log.Print(color.Red.Sprintf("[!] Failed to verify integrity of blocks [ %d - %d ]", i, toShouldbe))
+			return false
+
+		}
+
+		if err := db.PutIntegrityFindings(_db, i, toShouldbe, findings); err != nil {
+
+			log.Print(color.Red.Sprintf("[!] Failed to persist integrity findings : %s", err.Error()))
+			return false
+
+		}
+
+		for _, v := range findings {
+			log.Print(color.Red.Sprintf("[!] Block %d [ %s ] : %s", v.Number, v.Kind, v.Detail))
+		}
+
+		count += len(findings)
+		verified += _verified
+
+	}
+
+	if count != 0 {
+
+		log.Print(color.Red.Sprintf("[!] Found %d inconsistencies in [ %d - %d ] [ Verified : %d ], consider re-indexing those blocks", count, from, to, verified))
+		return false
+
+	}
+
+	log.Print(color.Green.Sprintf("[+] No inconsistencies in [ %d - %d ] [ Verified : %d ]", from, to, verified))
+	return true
+
+}
+
+// Verify - Checks whether `ette` can run with given configuration i.e. all
+// of database, redis & blockchain nodes are reachable, while reporting blocks
+// missing in database, returns true if nothing's wrong
+//
+// If asked for, integrity of persisted blocks in [from, to] range is also
+// verified, where range defaults to all persisted blocks
+//
+// Config file must be read before invoking it
+func Verify(integrity bool, from uint64, fromGiven bool, to uint64, toGiven bool, samples uint64) bool {
+
+	ok := true
+
+	_db := db.Connect()
+	log.Print(color.Green.Sprintf("[+] Connected to database"))
+
+	if _redisClient := getRedisClient(); _redisClient == nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to connect to Redis Server"))
+		ok = false
+
+	} else {
+
+		log.Print(color.Green.Sprintf("[+] Connected to Redis Server"))
+		_redisClient.Close()
+
+	}
+
+	_connection := &d.BlockChainNodeConnection{
+		RPC:       getClient(true),
+		Websocket: getClient(false),
+	}
+
+	if !verifyEndpoints("RPC", _connection.RPC) {
+		ok = false
+	}
+
+	if !verifyEndpoints("Websocket", _connection.Websocket) {
+		ok = false
+	}
+
+	count := db.GetBlockCount(_db)
+	if count == 0 {
+
+		log.Printf("[*] No blocks in database\n")
+		return ok
+
+	}
+
+	oldest := db.GetCurrentOldestBlockNumber(_db)
+	latest := db.GetCurrentBlockNumber(_db)
+
+	missing := blk.MissingBlocksInRange(_db, oldest, latest)
+	if len(missing) != 0 {
+
+		log.Print(color.Red.Sprintf("[!] Missing %d block(s) in [ %d - %d ], first one being %d", len(missing), oldest, latest, missing[0]))
+		ok = false
+
+	} else {
+
+		log.Print(color.Green.Sprintf("[+] No missing blocks in [ %d - %d ] [ Count : %d ]", oldest, latest, count))
+
+	}
+
+	if !integrity {
+		return ok
+	}
+
+	if !fromGiven || from < oldest {
+		from = oldest
+	}
+
+	if !toGiven || to > latest {
+		to = latest
+	}
+
+	if from > to {
+
+		log.Print(color.Red.Sprintf("[!] Bad block range [ %d - %d ]", from, to))
+		return false
+
+	}
+
+	return verifyIntegrity(_connection, _db, from, to, samples) && ok
+
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/itzmeanjan/ette/app/data"
+)
+
+// errForbiddenAddress - Webhook host resolved to address, which isn't
+// publicly routable
+var errForbiddenAddress = errors.New("forbidden address")
+
+// control - Checks address, right before connecting to it, i.e. after host
+// name is resolved, so that webhook can't point to `ette`'s own host/ network,
+// even when its DNS record changes after registration
+func control(_ string, address string, _ syscall.RawConn) error {
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !data.IsPublicIP(ip) {
+		return errForbiddenAddress
+	}
+
+	return nil
+
+}
+
+// newClient - HTTP client to be used for delivering to webhooks, which never
+// goes through proxy & never follows redirects, because either of them would
+// let request reach address, which isn't checked
+func newClient(timeout time.Duration) *http.Client {
+
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   control,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 4,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+}
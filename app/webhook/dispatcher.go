@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/itzmeanjan/ette/app/broker"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/pubsub"
+	"gorm.io/gorm"
+)
+
+// topics - Top level topics, real-time data is published on, to which
+// webhooks can subscribe
+var topics = []string{"block", "transaction", "event", "reorg", "transfer", "pending"}
+
+// Dispatcher - Delivers real-time data published on broker topics, to all
+// registered webhooks, whose topic & filters are matching
+//
+// Each webhook is delivered to by its own worker, in order, so that slow/ failing
+// endpoints don't hold back delivery to others
+type Dispatcher struct {
+	Broker  broker.Broker
+	DB      *gorm.DB
+	Client  *http.Client
+	Workers map[string]*worker
+	Lock    *sync.RWMutex
+}
+
+// NewDispatcher - Creates webhook dispatcher, which is to be started
+func NewDispatcher(_broker broker.Broker, _db *gorm.DB) *Dispatcher {
+	return &Dispatcher{
+		Broker:  _broker,
+		DB:      _db,
+		Client:  newClient(time.Duration(cfg.GetWebhookTimeout()) * time.Second),
+		Workers: make(map[string]*worker),
+		Lock:    &sync.RWMutex{},
+	}
+}
+
+// Start - Subscribes to all topics & keeps delivering data received on them
+// to webhooks, while looking up registered ones every 10 seconds, so that
+// newly registered/ deleted webhooks are picked up, until context is cancelled
+func (d *Dispatcher) Start(ctx context.Context) {
+
+	d.refresh()
+
+	for _, topic := range topics {
+
+		sub, err := d.Broker.Subscribe(topic)
+		if err != nil {
+			log.Printf("[!] Failed to subscribe to `%s` topic, for webhooks : %s\n", topic, err.Error())
+			continue
+		}
+
+		go d.listen(ctx, topic, sub)
+
+	}
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			d.stop()
+			return
+
+		case <-time.After(time.Duration(10) * time.Second):
+			d.refresh()
+
+		}
+
+	}
+
+}
+
+// listen - Keeps handing over data received on topic to workers of webhooks
+// it matches with, until context is cancelled
+func (d *Dispatcher) listen(ctx context.Context, topic string, sub broker.Subscription) {
+
+	defer sub.Close()
+
+	for {
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		msg, err := sub.Next(time.Second)
+		switch err {
+
+		case nil:
+			d.dispatch(topic, msg)
+		case broker.ErrTimeout:
+			continue
+		default:
+			return
+
+		}
+
+	}
+
+}
+
+// dispatch - Queues data published on topic, for delivery to each webhook
+// it matches with
+func (d *Dispatcher) dispatch(topic string, msg string) {
+
+	d.Lock.RLock()
+	defer d.Lock.RUnlock()
+
+	for _, w := range d.Workers {
+
+		if w.Request.Topic() != topic || !w.Request.DoesMatchWithPublishedData(msg) {
+			continue
+		}
+
+		w.enqueue(msg)
+
+	}
+
+}
+
+// refresh - Starts workers for webhooks registered since last time, while
+// stopping those of deleted ones
+func (d *Dispatcher) refresh() {
+
+	webhooks, err := db.GetAllWebhooks(db.Reader(d.DB))
+	if err != nil {
+		log.Printf("[!] Failed to read registered webhooks : %s\n", err.Error())
+		return
+	}
+
+	registered := make(map[string]bool)
+
+	d.Lock.Lock()
+	defer d.Lock.Unlock()
+
+	for _, v := range webhooks {
+
+		registered[v.ID] = true
+
+		if _, ok := d.Workers[v.ID]; ok {
+			continue
+		}
+
+		w := newWorker(v, &pubsub.SubscriptionRequest{Name: v.Topic, Type: "subscribe", APIKey: v.APIKey}, d.DB, d.Client)
+		d.Workers[v.ID] = w
+
+		go w.run()
+
+	}
+
+	for k, w := range d.Workers {
+
+		if registered[k] {
+			continue
+		}
+
+		w.stop()
+		delete(d.Workers, k)
+
+	}
+
+}
+
+// stop - Stops all workers, dropping data which is yet to be delivered
+func (d *Dispatcher) stop() {
+
+	d.Lock.Lock()
+	defer d.Lock.Unlock()
+
+	for k, w := range d.Workers {
+
+		w.stop()
+		delete(d.Workers, k)
+
+	}
+
+}
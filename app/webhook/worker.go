@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	"github.com/itzmeanjan/ette/app/pubsub"
+	"gorm.io/gorm"
+)
+
+const (
+	// queueSize - How many pieces of data can be waiting to be delivered to
+	// webhook, beyond which they're put in dead letter table, right away
+	queueSize = 1000
+	// maxRetryDelay - Delay between delivery attempts keeps doubling, but
+	// never beyond this
+	maxRetryDelay = time.Minute
+)
+
+// worker - Delivers data queued for webhook, in order, retrying each one with
+// exponential backoff, until it's delivered or attempts run out
+type worker struct {
+	Webhook *db.Webhooks
+	Request *pubsub.SubscriptionRequest
+	DB      *gorm.DB
+	Client  *http.Client
+	Queue   chan string
+	Done    chan struct{}
+	Once    sync.Once
+}
+
+// newWorker - Creates worker for webhook, which is to be run
+func newWorker(webhook *db.Webhooks, req *pubsub.SubscriptionRequest, _db *gorm.DB, client *http.Client) *worker {
+	return &worker{
+		Webhook: webhook,
+		Request: req,
+		DB:      _db,
+		Client:  client,
+		Queue:   make(chan string, queueSize),
+		Done:    make(chan struct{}),
+	}
+}
+
+// enqueue - Queues data for delivery, without blocking, when queue is
+// already full, data is put in dead letter table
+func (w *worker) enqueue(msg string) {
+
+	select {
+
+	case w.Queue <- msg:
+
+	default:
+
+		metrics.WebhookDelivered("dead")
+		db.PutWebhookDeadLetter(w.DB, w.Webhook.ID, msg, 0, "Delivery queue full")
+
+	}
+
+}
+
+// run - Keeps delivering queued data, until stopped
+func (w *worker) run() {
+
+	for {
+
+		select {
+
+		case <-w.Done:
+			return
+
+		case msg := <-w.Queue:
+			w.deliver(msg)
+
+		}
+
+	}
+
+}
+
+// stop - Stops worker, data being retried/ queued is dropped
+func (w *worker) stop() {
+
+	w.Once.Do(func() {
+		close(w.Done)
+	})
+
+}
+
+// deliver - Attempts to deliver data to webhook, as long as app it's registered
+// for is enabled & user is under rate limit, putting it in dead letter table
+// when all attempts fail
+//
+// Only data which gets delivered, is counted against plan of user
+func (w *worker) deliver(msg string) {
+
+	user := db.GetUserFromAPIKey(w.DB, w.Webhook.APIKey)
+	if user == nil || !user.Enabled {
+		return
+	}
+
+	if !db.IsUnderRateLimit(w.DB, user.Address) {
+		return
+	}
+
+	attempts := cfg.GetWebhookMaxAttempts()
+	delay := time.Second
+
+	// Only status code of last response is kept in dead letter, never
+	// error, because it's readable by user
+	var status int
+	var err error
+
+	for i := uint64(1); i <= attempts; i++ {
+
+		if status, err = w.post(msg); err == nil {
+
+			metrics.WebhookDelivered("delivered")
+			db.PutDataDeliveryInfo(w.DB, user.Address, fmt.Sprintf("/v1/webhook/%s", w.Request.Topic()), uint64(len(msg)))
+			return
+
+		}
+
+		metrics.WebhookDelivered("failed")
+		log.Printf("[!] Failed to deliver to webhook %s [ Attempt : %d/%d ] : %s\n", w.Webhook.ID, i, attempts, err.Error())
+
+		if i == attempts {
+			break
+		}
+
+		select {
+		case <-w.Done:
+			return
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+
+	}
+
+	metrics.WebhookDelivered("dead")
+	db.PutWebhookDeadLetter(w.DB, w.Webhook.ID, msg, attempts, reason(status))
+
+}
+
+// reason - Why delivery failed, to be shown to user, which reveals nothing
+// more than status code of response, if any was received
+func reason(status int) string {
+
+	if status == 0 {
+		return "Delivery failed"
+	}
+
+	return fmt.Sprintf("Responded with %d", status)
+
+}
+
+// post - POSTs data to webhook URL, signing it when secret is set, where
+// anything other than 2xx response is considered to be failure, returning
+// status code of response, if any was received
+func (w *worker) post(msg string) (int, error) {
+
+	req, err := http.NewRequest(http.MethodPost, w.Webhook.URL, strings.NewReader(msg))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ette")
+	req.Header.Set("X-Ette-Webhook", w.Webhook.ID)
+	req.Header.Set("X-Ette-Topic", w.Webhook.Topic)
+	req.Header.Set("X-Ette-Timestamp", timestamp)
+
+	if w.Webhook.Secret != "" {
+		req.Header.Set("X-Ette-Signature", Sign(w.Webhook.Secret, timestamp, msg))
+	}
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	// Body is drained, so that connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("responded with %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+
+}
+
+// Sign - Computes signature of data delivered to webhook, which is HMAC-SHA256
+// of `<timestamp>.<body>`, using secret set for webhook, so that receiver can
+// check it's coming from `ette` & it's not being replayed
+func Sign(secret string, timestamp string, body string) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + body))
+
+	return fmt.Sprintf("sha256=%s", hex.EncodeToString(mac.Sum(nil)))
+
+}
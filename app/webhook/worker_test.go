@@ -0,0 +1,71 @@
+package webhook
+
+import "testing"
+
+func TestSign(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      string
+		signature string
+	}{
+		{
+			name:      "empty body",
+			secret:    "key",
+			timestamp: "1700000000",
+			body:      "",
+			signature: "sha256=0f1cc1f811f42fd12af9618acf321769899fa521fe07a642f70a61785e130770",
+		},
+		{
+			name:      "json body",
+			secret:    "secret",
+			timestamp: "1700000000",
+			body:      `{"number":1}`,
+			signature: "sha256=265b800f779e7205487cd8cfa6fca6734b15c2ce711d3888eca194e64c4d3e8d",
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			if signature := Sign(tt.secret, tt.timestamp, tt.body); signature != tt.signature {
+				t.Errorf("expected %s, got %s", tt.signature, signature)
+			}
+
+		})
+
+	}
+
+}
+
+func TestSignChangesWithInput(t *testing.T) {
+
+	base := Sign("secret", "1700000000", "body")
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      string
+	}{
+		{name: "secret", secret: "other", timestamp: "1700000000", body: "body"},
+		{name: "timestamp", secret: "secret", timestamp: "1700000001", body: "body"},
+		{name: "body", secret: "secret", timestamp: "1700000000", body: "bodY"},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			if Sign(tt.secret, tt.timestamp, tt.body) == base {
+				t.Errorf("expected signature to change with %s", tt.name)
+			}
+
+		})
+
+	}
+
+}
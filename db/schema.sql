@@ -17,7 +17,11 @@ create table blocks (
     unclehash char(66) not null,
     txroothash char(66) not null,
     receiptroothash char(66) not null,
-    extradata bytea
+    extradata bytea,
+    basefee varchar,
+    blobgasused bigint not null default 0,
+    excessblobgas bigint not null default 0,
+    withdrawals json
 );
 
 create index on blocks(number asc);
@@ -36,6 +40,17 @@ create table transactions (
     nonce bigint not null,
     state smallint not null,
     blockhash char(66) not null,
+    type smallint not null default 0,
+    maxfeepergas varchar,
+    maxpriorityfeepergas varchar,
+    effectivegasprice varchar,
+    gasused bigint not null default 0,
+    accesslist json,
+    blobgas bigint not null default 0,
+    maxfeeperblobgas varchar,
+    blobgasprice varchar,
+    blobhashes text[],
+    blocknumber bigint not null default 0,
     foreign key (blockhash) references blocks(hash) on delete cascade
 );
 
@@ -52,6 +67,7 @@ create table events (
     data bytea,
     txhash char(66) not null,
     blockhash char(66) not null,
+    blocknumber bigint not null default 0,
     primary key (blockhash, index),
     foreign key (txhash) references transactions(hash) on delete cascade,
     foreign key (blockhash) references blocks(hash) on delete cascade
@@ -81,6 +97,29 @@ create table delivery_history (
 create index on delivery_history(client);
 create index on delivery_history(ts asc);
 
+create table webhooks (
+    id uuid default gen_random_uuid() primary key,
+    apikey char(66) not null,
+    topic varchar(400) not null,
+    url varchar(2048) not null,
+    secret varchar(256) not null,
+    ts timestamp not null
+);
+
+create index on webhooks(apikey);
+
+create table webhook_dead_letters (
+    id uuid default gen_random_uuid() primary key,
+    webhook uuid not null,
+    payload text not null,
+    attempts int not null,
+    error text not null,
+    ts timestamp not null
+);
+
+create index on webhook_dead_letters(webhook);
+create index on webhook_dead_letters(ts asc);
+
 create table subscription_plans (
     id serial primary key,
     name varchar(20) not null unique,
@@ -94,3 +133,135 @@ create table subscription_details (
 );
 
 create index on subscription_details(subscriptionplan);
+
+create table reorgs (
+    id uuid default gen_random_uuid() primary key,
+    ancestor bigint not null,
+    depth bigint not null,
+    oldhead char(66) not null,
+    newhead char(66) not null,
+    oldhashes text[] not null,
+    newhashes text[] not null,
+    ts timestamp not null
+);
+
+create index on reorgs(ancestor);
+create index on reorgs(ts asc);
+
+create table token_transfers (
+    blockhash char(66) not null,
+    index integer not null,
+    batchindex integer not null,
+    token char(42) not null,
+    standard varchar(10) not null,
+    from char(42) not null,
+    to char(42) not null,
+    tokenid varchar,
+    amount varchar not null,
+    txhash char(66) not null,
+    primary key (blockhash, index, batchindex),
+    foreign key (blockhash) references blocks(hash) on delete cascade,
+    foreign key (txhash) references transactions(hash) on delete cascade
+);
+
+create index on token_transfers(token);
+create index on token_transfers(from);
+create index on token_transfers(to);
+create index on token_transfers(txhash);
+
+create table internal_transactions (
+    txhash char(66) not null,
+    index integer not null,
+    depth integer not null,
+    type varchar(20) not null,
+    from char(42) not null,
+    to char(42),
+    contract char(42),
+    value varchar not null,
+    gas bigint not null,
+    gasused bigint not null,
+    error text,
+    blockhash char(66) not null,
+    primary key (txhash, index),
+    foreign key (txhash) references transactions(hash) on delete cascade,
+    foreign key (blockhash) references blocks(hash) on delete cascade
+);
+
+create index on internal_transactions(from);
+create index on internal_transactions(to);
+create index on internal_transactions(contract);
+create index on internal_transactions(blockhash);
+
+create table abis (
+    address char(42) primary key,
+    abi text not null,
+    uploader char(42) not null,
+    ts timestamp not null
+);
+
+create index on abis(uploader);
+
+create table integrity_findings (
+    id uuid default gen_random_uuid() primary key,
+    number bigint not null,
+    hash char(66) not null,
+    kind varchar(20) not null,
+    detail text not null,
+    ts timestamp not null
+);
+
+create index on integrity_findings(number);
+create index on integrity_findings(kind);
+
+create table queued_blocks (
+    number bigint primary key,
+    unconfirmedprogress boolean not null,
+    published boolean not null,
+    unconfirmeddone boolean not null,
+    confirmedprogress boolean not null,
+    confirmeddone boolean not null,
+    lastattempted timestamp not null,
+    delay bigint not null
+);
+
+create table ingestion_filter (
+    id smallint primary key,
+    addresses text[] not null,
+    contracts text[] not null,
+    topics text[] not null,
+    ts timestamp not null
+);
+
+-- When `Partitioning` is enabled, while starting with new database, `blocks`,
+-- `transactions` & `events` are partitioned by block number range instead,
+-- with partition key being part of every unique constraint & without foreign
+-- keys referring to blocks, while rest stays same
+--
+-- Partitions, each holding `PartitionSize` blocks, are created as chain grows
+
+create table blocks (
+    hash char(66) not null,
+    number bigint not null,
+    -- ...
+    primary key (hash, number),
+    unique (number)
+) partition by range (number);
+
+create table transactions (
+    hash char(66) not null,
+    -- ...
+    blocknumber bigint not null default 0,
+    primary key (hash, blocknumber)
+) partition by range (blocknumber);
+
+create table events (
+    blockhash char(66) not null,
+    index integer not null,
+    -- ...
+    blocknumber bigint not null default 0,
+    primary key (blockhash, index, blocknumber)
+) partition by range (blocknumber);
+
+create table blocks_p0 partition of blocks for values from (0) to (1000000);
+create table transactions_p0 partition of transactions for values from (0) to (1000000);
+create table events_p0 partition of events for values from (0) to (1000000);